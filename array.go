@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+
+	"golang.org/x/text/message"
+)
+
+// Array returns the validator to verify an array.
+// A must be an array type of which elements are T, such as [16]byte; otherwise Array panics.
+//
+// The named args available in its error format are the same as Slice.
+//
+//	v := validator.Array[[3]float64](validator.InRange(0.0, 1.0))
+func Array[A any, T any](vs ...Validator[T]) Validator[A] {
+	t := reflect.TypeFor[A]()
+	if t.Kind() != reflect.Array || t.Elem() != reflect.TypeFor[T]() {
+		panic("validator: " + t.String() + " is not an array of " + reflect.TypeFor[T]().String())
+	}
+	return &arrayValidator[A, T]{
+		vs:     vs,
+		format: sliceElemErrorFormat,
+	}
+}
+
+// arrayValidator represents the validator to check array elements.
+type arrayValidator[A any, T any] struct {
+	vs      []Validator[T]
+	format  *errorFormat
+	workers int
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *arrayValidator[A, T]) WithFormat(key message.Reference, a ...Arg) Validator[A] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// parallel returns shallow copy of r that validates with n goroutines.
+func (r *arrayValidator[A, T]) parallel(n int) Validator[A] {
+	rr := *r
+	rr.workers = n
+	return &rr
+}
+
+// Validate validates v.
+func (r *arrayValidator[A, T]) Validate(ctx context.Context, v A) error {
+	p := reflect.ValueOf(v)
+	errs := make([]error, p.Len())
+	forEach(ctx, p.Len(), r.workers, func(i int) bool {
+		elem := valueAs[T](p.Index(i))
+		errs[i] = validateElem(ctx, i, elem, r.vs, r.format)
+		return abortError(ctx, errs[i]) == nil
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var m OrderedMap[int, error]
+	for i, err := range errs {
+		if err := abortError(ctx, err); err != nil {
+			return err
+		}
+		if err != nil {
+			m.set(i, err)
+		}
+	}
+	if m.Len() > 0 {
+		return &ArrayError[A, T]{
+			Value:  v,
+			Errors: &m,
+		}
+	}
+	return nil
+}
+
+// ArrayError reports an error is caused in Array validator.
+type ArrayError[A any, T any] struct {
+	Value  A
+	Errors *OrderedMap[int, error]
+}
+
+// Error implements the error interface.
+func (e ArrayError[A, T]) Error() string {
+	return joinErrors(e.Unwrap()...).Error()
+}
+
+// Unwrap returns each errors of err.
+func (e ArrayError[A, T]) Unwrap() []error {
+	n := e.Errors.Len()
+	if n == 0 {
+		return nil
+	}
+	errs := make([]error, 0, n)
+	for _, key := range e.Errors.Keys() {
+		err, _ := e.Errors.Get(key)
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+var (
+	_ Validator[[1]any]    = (*arrayValidator[[1]any, any])(nil)
+	_ parallelizer[[1]any] = (*arrayValidator[[1]any, any])(nil)
+	_ Error                = (*ArrayError[[1]any, any])(nil)
+)
@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestArray(t *testing.T) {
+	t.Run("float", func(t *testing.T) {
+		v := Array[[3]float64](InRange(0.0, 1.0))
+		testValidate(t, v, [3]float64{0, 0.5, 1}, "")
+		testValidate(t, v, [3]float64{0, 1.5, -1}, "index 1: must be in range(0 ... 1)\nindex 2: must be in range(0 ... 1)")
+	})
+	t.Run("named", func(t *testing.T) {
+		type UUID [16]byte
+		v := Array[UUID](Max[byte](0x7f))
+		testValidate(t, v, UUID{}, "")
+		testValidate(t, v, UUID{15: 0x80}, "index 15: must be no greater than 127")
+	})
+	t.Run("empty", func(t *testing.T) {
+		v := Array[[0]int](Min(1))
+		testValidate(t, v, [0]int{}, "")
+	})
+}
+
+func TestArray_nilElement(t *testing.T) {
+	v := Array[[2]any](RequiredInterface[any]())
+	testValidate(t, v, [2]any{1, 2}, "")
+	testValidate(t, v, [2]any{1, nil}, "index 1: cannot be the zero value")
+}
+
+func TestArray_notArray(t *testing.T) {
+	defer func() {
+		if e := recover(); e == nil {
+			t.Errorf("Array should panic")
+		}
+	}()
+	Array[[]int](Min(1))
+}
+
+func TestArrayError(t *testing.T) {
+	v := Array[[3]int](Min(1))
+	err := v.Validate(context.Background(), [3]int{0, 1, 0})
+	e, ok := err.(*ArrayError[[3]int, int])
+	if !ok {
+		t.Fatalf("Validate() = %T; want *ArrayError", err)
+	}
+	if keys := e.Errors.Keys(); len(keys) != 2 || keys[0] != 0 || keys[1] != 2 {
+		t.Errorf("Keys() = %v; want [0 2]", keys)
+	}
+	a := Violations(err)
+	if len(a) != 2 || a[1].Path.String() != "[2]" {
+		t.Errorf("Violations() = %v", a)
+	}
+}
+
+func TestArrayWithFormat(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		v := Array[[2]int](Min(1)).WithFormat("[%d] %v", ByName("index"), ByName("error"))
+		testValidate(t, v, [2]int{1, 0}, "[1] must be no less than 1")
+	})
+}
@@ -21,9 +21,13 @@ type errorFormat struct {
 
 var (
 	requiredErrorFormat = newFormat("cannot be the zero value")
+	nonNilErrorFormat   = newFormat("must not be nil")
 	inErrorFormat       = newFormat("must be a valid value in %[1]v", ByName("validValues"))
 	patternErrorFormat  = newFormat("must match the pattern /%[1]v/", ByName("pattern"))
 	customErrorFormat   = newFormat("must be a valid value")
+	orErrorFormat       = newFormat("must satisfy one of: %[1]v", ByName("errors"))
+	joinErrorFormat     = newFormat("must satisfy all of: %[1]v", ByName("errors"))
+	notErrorFormat      = newFormat("must not satisfy: %[1]v", ByName("description"))
 
 	minLengthErrorFormat = newFormat("the length must be no less than %[1]d", ByName("min"))
 	maxLengthErrorFormat = newFormat("the length must be no greater than %[1]d", ByName("max"))
@@ -33,7 +37,21 @@ var (
 	maxErrorFormat     = newFormat("must be no greater than %[1]v", ByName("max"))
 	inRangeErrorFormat = newFormat("must be in range(%[1]v ... %[2]v)", ByName("min"), ByName("max"))
 
+	minItemsErrorFormat   = newFormat("the number of items must be no less than %[1]d", ByName("min"))
+	maxItemsErrorFormat   = newFormat("the number of items must be no greater than %[1]d", ByName("max"))
+	itemsRangeErrorFormat = newFormat("the number of items must be in range(%[1]d ... %[2]d)", ByName("min"), ByName("max"))
+	uniqueErrorFormat     = newFormat("must not contain duplicate items %[1]v", ByName("duplicates"))
+	uniqueItemsFormat     = newFormat("must not contain duplicate items")
+
+	equalFieldErrorFormat       = newFormat("must be equal to %[1]s", ByName("field"))
+	notEqualFieldErrorFormat    = newFormat("must not be equal to %[1]s", ByName("field"))
+	greaterThanFieldErrorFormat = newFormat("must be greater than %[1]s", ByName("field"))
+	lessThanFieldErrorFormat    = newFormat("must be less than %[1]s", ByName("field"))
+
 	structFieldErrorFormat = newFormat("%[1]s: %[2]v", ByName("name"), ByName("error"))
+	sliceElemErrorFormat   = newFormat("index %[1]d: %[2]v", ByName("index"), ByName("error"))
+	mapKeyErrorFormat      = newFormat("key %[1]v: %[2]v", ByName("key"), ByName("error"))
+	mapValueErrorFormat    = newFormat("%[1]v: %[2]v", ByName("key"), ByName("error"))
 )
 
 func newFormat(key string, a ...Arg) *errorFormat {
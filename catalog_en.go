@@ -6,9 +6,13 @@ import (
 
 func init() {
 	DefaultCatalog.SetString(language.English, requiredErrorFormat.ID, "cannot be the zero value")
+	DefaultCatalog.SetString(language.English, nonNilErrorFormat.ID, "must not be nil")
 	DefaultCatalog.SetString(language.English, inErrorFormat.ID, "must be a valid value in %[1]v")
 	DefaultCatalog.SetString(language.English, patternErrorFormat.ID, "must match the pattern /%[1]v/")
 	DefaultCatalog.SetString(language.English, customErrorFormat.ID, "must be a valid value")
+	DefaultCatalog.SetString(language.English, orErrorFormat.ID, "must satisfy one of: %[1]v")
+	DefaultCatalog.SetString(language.English, joinErrorFormat.ID, "must satisfy all of: %[1]v")
+	DefaultCatalog.SetString(language.English, notErrorFormat.ID, "must not satisfy: %[1]v")
 
 	DefaultCatalog.SetString(language.English, minLengthErrorFormat.ID, "the length must be no less than %[1]d")
 	DefaultCatalog.SetString(language.English, maxLengthErrorFormat.ID, "the length must be no greater than %[1]d")
@@ -18,5 +22,19 @@ func init() {
 	DefaultCatalog.SetString(language.English, maxErrorFormat.ID, "must be no greater than %[1]v")
 	DefaultCatalog.SetString(language.English, inRangeErrorFormat.ID, "must be in range(%[1]v ... %[2]v)")
 
+	DefaultCatalog.SetString(language.English, minItemsErrorFormat.ID, "the number of items must be no less than %[1]d")
+	DefaultCatalog.SetString(language.English, maxItemsErrorFormat.ID, "the number of items must be no greater than %[1]d")
+	DefaultCatalog.SetString(language.English, itemsRangeErrorFormat.ID, "the number of items must be in range(%[1]d ... %[2]d)")
+	DefaultCatalog.SetString(language.English, uniqueErrorFormat.ID, "must not contain duplicate items %[1]v")
+	DefaultCatalog.SetString(language.English, uniqueItemsFormat.ID, "must not contain duplicate items")
+
+	DefaultCatalog.SetString(language.English, equalFieldErrorFormat.ID, "must be equal to %[1]s")
+	DefaultCatalog.SetString(language.English, notEqualFieldErrorFormat.ID, "must not be equal to %[1]s")
+	DefaultCatalog.SetString(language.English, greaterThanFieldErrorFormat.ID, "must be greater than %[1]s")
+	DefaultCatalog.SetString(language.English, lessThanFieldErrorFormat.ID, "must be less than %[1]s")
+
 	DefaultCatalog.SetString(language.English, structFieldErrorFormat.ID, "%[1]s: %[2]v")
+	DefaultCatalog.SetString(language.English, sliceElemErrorFormat.ID, "index %[1]d: %[2]v")
+	DefaultCatalog.SetString(language.English, mapKeyErrorFormat.ID, "key %[1]v: %[2]v")
+	DefaultCatalog.SetString(language.English, mapValueErrorFormat.ID, "%[1]v: %[2]v")
 }
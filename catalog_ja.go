@@ -6,9 +6,13 @@ import (
 
 func init() {
 	DefaultCatalog.SetString(language.Japanese, requiredErrorFormat.ID, "必須です")
+	DefaultCatalog.SetString(language.Japanese, nonNilErrorFormat.ID, "nilであってはいけません")
 	DefaultCatalog.SetString(language.Japanese, inErrorFormat.ID, "%[1]vのいずれかでなければなりません")
 	DefaultCatalog.SetString(language.Japanese, patternErrorFormat.ID, "%[1]vのパターンに一致しなければなりません")
 	DefaultCatalog.SetString(language.Japanese, customErrorFormat.ID, "有効な値でなければなりません")
+	DefaultCatalog.SetString(language.Japanese, orErrorFormat.ID, "次のいずれかを満たす必要があります: %[1]v")
+	DefaultCatalog.SetString(language.Japanese, joinErrorFormat.ID, "次のすべてを満たす必要があります: %[1]v")
+	DefaultCatalog.SetString(language.Japanese, notErrorFormat.ID, "次の条件を満たしてはいけません: %[1]v")
 
 	DefaultCatalog.SetString(language.Japanese, minLengthErrorFormat.ID, "%[1]d文字以上の長さが必要です")
 	DefaultCatalog.SetString(language.Japanese, maxLengthErrorFormat.ID, "%[1]d文字以内の長さに制限されています")
@@ -18,5 +22,19 @@ func init() {
 	DefaultCatalog.SetString(language.Japanese, maxErrorFormat.ID, "%[1]v以下の値が必要です")
 	DefaultCatalog.SetString(language.Japanese, inRangeErrorFormat.ID, "%[1]v以上%[2]d以下の値が必要です")
 
+	DefaultCatalog.SetString(language.Japanese, minItemsErrorFormat.ID, "%[1]d個以上の要素が必要です")
+	DefaultCatalog.SetString(language.Japanese, maxItemsErrorFormat.ID, "%[1]d個以下の要素に制限されています")
+	DefaultCatalog.SetString(language.Japanese, itemsRangeErrorFormat.ID, "要素数は%[1]d以上%[2]d以下の制限があります")
+	DefaultCatalog.SetString(language.Japanese, uniqueErrorFormat.ID, "%[1]vが重複しています")
+	DefaultCatalog.SetString(language.Japanese, uniqueItemsFormat.ID, "要素が重複してはいけません")
+
+	DefaultCatalog.SetString(language.Japanese, equalFieldErrorFormat.ID, "%[1]sと一致しなければなりません")
+	DefaultCatalog.SetString(language.Japanese, notEqualFieldErrorFormat.ID, "%[1]sと異なる値でなければなりません")
+	DefaultCatalog.SetString(language.Japanese, greaterThanFieldErrorFormat.ID, "%[1]sより大きい値が必要です")
+	DefaultCatalog.SetString(language.Japanese, lessThanFieldErrorFormat.ID, "%[1]sより小さい値が必要です")
+
 	DefaultCatalog.SetString(language.Japanese, structFieldErrorFormat.ID, "%[1]s: %[2]v")
+	DefaultCatalog.SetString(language.Japanese, sliceElemErrorFormat.ID, "インデックス%[1]d: %[2]v")
+	DefaultCatalog.SetString(language.Japanese, mapKeyErrorFormat.ID, "キー%[1]v: %[2]v")
+	DefaultCatalog.SetString(language.Japanese, mapValueErrorFormat.ID, "%[1]v: %[2]v")
 }
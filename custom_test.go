@@ -58,10 +58,32 @@ func Example_customValidator() {
 		ConfirmationPassword: "abcd",
 	})
 	fmt.Println(err)
-	// Unordered output:
+	// Output:
 	// name: the length must be in range(5 ... 20)
 	// name: does not allow not-alphabets or not-digits
 	// password: the length must be no less than 8
 	// confirmation-password: the length must be no less than 8
 	// passwords does not match
 }
+
+var createUserRequestFieldValidator = validator.Struct(func(s validator.StructRule, r *CreateUserRequest) {
+	validator.AddField(s, &r.Name, "name",
+		validator.Length[string](5, 20),
+		usernameValidator)
+	validator.AddField(s, &r.Password, "password",
+		validator.MinLength[string](8))
+	validator.AddField(s, &r.ConfirmationPassword, "confirmation-password",
+		validator.EqualField(s, &r.Password, "password"))
+})
+
+func Example_crossField() {
+	ctx := context.Background()
+	err := createUserRequestFieldValidator.Validate(ctx, &CreateUserRequest{
+		Name:                 "admin",
+		Password:             "12345678",
+		ConfirmationPassword: "abcdefgh",
+	})
+	fmt.Println(err)
+	// Output:
+	// confirmation-password: must be equal to password
+}
@@ -40,10 +40,10 @@ func Example() {
 	r.Options = []string{"option3"}
 	err := requestValidator.Validate(context.Background(), &r)
 	fmt.Println(err)
-	// Unordered output:
-	// user: name: cannot be the zero value
+	// Output:
 	// user: id: the length must be in range(5 ... 10)
-	// options: must be a valid value in [option1 option2]
+	// user: name: cannot be the zero value
+	// options: index 0: must be a valid value in [option1 option2]
 }
 
 func Example_localized() {
@@ -74,10 +74,10 @@ func Example_localized() {
 	r.Options = []string{"option3"}
 	err := requestValidator.Validate(ctx, &r)
 	fmt.Println(err)
-	// Unordered output:
-	// user: name: 必須です
+	// Output:
 	// user: id: 長さは5以上10以内の制限があります
-	// options: [option1 option2]のいずれかでなければなりません
+	// user: name: 必須です
+	// options: インデックス0: [option1 option2]のいずれかでなければなりません
 }
 
 func Example_separated() {
@@ -111,7 +111,7 @@ func Example_separated() {
 	var r Request
 	err := requestValidator.Validate(context.Background(), &r)
 	fmt.Println(err)
-	// Unordered output:
-	// user: name: cannot be the zero value
+	// Output:
 	// user: id: the length must be in range(5 ... 10)
+	// user: name: cannot be the zero value
 }
@@ -0,0 +1,138 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+
+	"golang.org/x/text/message"
+)
+
+// structBaseKey is the context key for the struct that is validated by Struct validator.
+type structBaseKey struct{}
+
+// EqualField returns the validator to verify the value is equal to the field p of the struct.
+// The rule name of its error is "equalField".
+//
+// s and p must be the arguments of the build function of Struct, and name is the name of p in the messages.
+// The returned validator is only available in the validators passed to AddField with the same s.
+// It is not available in a nested Struct; AddField panics if it is passed to AddField with the other StructRule,
+// and Validate panics if it is used in the validators of a nested Struct.
+//
+// Two named args are available in its error format.
+//   - field: the name of the field p (type string)
+//   - value: user input (type T)
+func EqualField[T comparable](s StructRule, p *T, name string) Validator[T] {
+	return newFieldValidator(s, p, name, "equalField", equalFieldErrorFormat, func(v, w T) bool {
+		return v == w
+	})
+}
+
+// NotEqualField returns the validator to verify the value is not equal to the field p of the struct.
+// The rule name of its error is "notEqualField".
+//
+// See EqualField for the details of the arguments and the named args available in its error format.
+func NotEqualField[T comparable](s StructRule, p *T, name string) Validator[T] {
+	return newFieldValidator(s, p, name, "notEqualField", notEqualFieldErrorFormat, func(v, w T) bool {
+		return v != w
+	})
+}
+
+// GreaterThanField returns the validator to verify the value is greater than the field p of the struct.
+// The rule name of its error is "greaterThanField".
+//
+// See EqualField for the details of the arguments and the named args available in its error format.
+func GreaterThanField[T ordered](s StructRule, p *T, name string) Validator[T] {
+	return newFieldValidator(s, p, name, "greaterThanField", greaterThanFieldErrorFormat, func(v, w T) bool {
+		return v > w
+	})
+}
+
+// LessThanField returns the validator to verify the value is less than the field p of the struct.
+// The rule name of its error is "lessThanField".
+//
+// See EqualField for the details of the arguments and the named args available in its error format.
+func LessThanField[T ordered](s StructRule, p *T, name string) Validator[T] {
+	return newFieldValidator(s, p, name, "lessThanField", lessThanFieldErrorFormat, func(v, w T) bool {
+		return v < w
+	})
+}
+
+func newFieldValidator[T any](s StructRule, p *T, name, rule string, format *errorFormat, fn func(v, w T) bool) *fieldValidator[T] {
+	return &fieldValidator[T]{
+		typ:    s.baseType(),
+		index:  s.indexOf(p),
+		name:   name,
+		rule:   rule,
+		fn:     fn,
+		format: format,
+	}
+}
+
+// fieldValidator represents the validator to compare the value with the other field of the struct.
+type fieldValidator[T any] struct {
+	typ    reflect.Type
+	index  []int
+	name   string
+	rule   string
+	fn     func(v, w T) bool
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *fieldValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *fieldValidator[T]) Validate(ctx context.Context, v T) error {
+	if !r.fn(v, r.fieldValue(ctx)) {
+		return r.ruleError(ctx, v)
+	}
+	return nil
+}
+
+// fieldValue returns the value of the field in the struct that is being validated.
+func (r *fieldValidator[T]) fieldValue(ctx context.Context) T {
+	base := reflect.ValueOf(ctx.Value(structBaseKey{}))
+	if !base.IsValid() || base.Type() != r.typ {
+		panic("validator: " + r.rule + " is used out of the Struct validator")
+	}
+	if base.IsNil() {
+		var t T
+		return t
+	}
+	return valueAs[T](base.Elem().FieldByIndex(r.index))
+}
+
+// structType returns the type of the struct pointer that r is built for.
+func (r *fieldValidator[T]) structType() reflect.Type {
+	return r.typ
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *fieldValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &fieldError[T]{
+		Field: r.name,
+		Value: v,
+	}
+	return newRuleError(ctx, r.rule, e, r.format)
+}
+
+// structBound is the interface that is implemented by the validators only available in the struct.
+type structBound interface {
+	structType() reflect.Type
+}
+
+// fieldError reports an error is caused in the validators comparing with the other field.
+type fieldError[T any] struct {
+	Field string `arg:"field"`
+	Value T      `arg:"value"`
+}
+
+var (
+	_ Validator[string] = (*fieldValidator[string])(nil)
+	_ ruler[string]     = (*fieldValidator[string])(nil)
+	_ structBound       = (*fieldValidator[string])(nil)
+)
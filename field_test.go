@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEqualField(t *testing.T) {
+	type Request struct {
+		Password             string
+		ConfirmationPassword string
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.Password, "password", MinLength[string](4))
+		AddField(s, &r.ConfirmationPassword, "confirmation-password", EqualField(s, &r.Password, "password"))
+	})
+	testValidate(t, v, &Request{"pass", "pass"}, "")
+	testValidate(t, v, &Request{"pass", "word"}, "confirmation-password: must be equal to password")
+	testValidate(t, v, &Request{"p", ""}, "password: the length must be no less than 4\nconfirmation-password: must be equal to password")
+}
+
+func TestNotEqualField(t *testing.T) {
+	type Request struct {
+		Old string
+		New string
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.New, "new", NotEqualField(s, &r.Old, "old"))
+	})
+	testValidate(t, v, &Request{"a", "b"}, "")
+	testValidate(t, v, &Request{"a", "a"}, "new: must not be equal to old")
+}
+
+func TestGreaterThanField(t *testing.T) {
+	type Range struct {
+		Start int
+		End   int
+	}
+	v := Struct(func(s StructRule, r *Range) {
+		AddField(s, &r.End, "end", GreaterThanField(s, &r.Start, "start"))
+		AddField(s, &r.Start, "start", LessThanField(s, &r.End, "end"))
+	})
+	testValidate(t, v, &Range{1, 2}, "")
+	testValidate(t, v, &Range{2, 2}, "end: must be greater than start\nstart: must be less than end")
+}
+
+func TestFieldWithFormat(t *testing.T) {
+	type Range struct {
+		Start int
+		End   int
+	}
+	v := Struct(func(s StructRule, r *Range) {
+		AddField(s, &r.End, "end", GreaterThanField(s, &r.Start, "start").WithFormat("%v is before %v", ByName("value"), ByName("field")))
+	})
+	testValidate(t, v, &Range{3, 2}, "end: 2 is before start")
+}
+
+func TestField_nested(t *testing.T) {
+	type (
+		Range struct {
+			Start int
+			End   int
+		}
+		Request struct {
+			Ranges []*Range
+		}
+	)
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.Ranges, "ranges", Slice(Struct(func(s StructRule, r *Range) {
+			AddField(s, &r.End, "end", GreaterThanField(s, &r.Start, "start"))
+		})))
+	})
+	testValidate(t, v, &Request{[]*Range{{1, 2}, {3, 4}}}, "")
+	testValidate(t, v, &Request{[]*Range{{1, 2}, {4, 3}}}, "ranges: index 1: end: must be greater than start")
+}
+
+func TestField_outOfStruct(t *testing.T) {
+	type Request struct {
+		A int
+		B int
+	}
+	var p Validator[int]
+	Struct(func(s StructRule, r *Request) {
+		p = EqualField(s, &r.A, "a")
+	})
+	defer func() {
+		if e := recover(); e == nil {
+			t.Errorf("Validate should panic")
+		}
+	}()
+	p.Validate(context.Background(), 1)
+}
+
+func TestField_otherStruct(t *testing.T) {
+	type (
+		Range struct {
+			Start int
+			End   int
+		}
+		Request struct {
+			Min    int
+			Ranges []*Range
+		}
+	)
+	defer func() {
+		if e := recover(); e == nil {
+			t.Errorf("AddField should panic")
+		}
+	}()
+	Struct(func(s StructRule, r *Request) {
+		outer := s
+		AddField(s, &r.Ranges, "ranges", Slice(Struct(func(s StructRule, p *Range) {
+			AddField(s, &p.Start, "start", GreaterThanField(outer, &r.Min, "min"))
+		})))
+	})
+}
+
+func TestEqualField_nilInterface(t *testing.T) {
+	type Request struct {
+		Value any
+		Other any
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.Value, "value", EqualField(s, &r.Other, "other"))
+	})
+	testValidate(t, v, &Request{}, "")
+	testValidate(t, v, &Request{Value: 1}, "value: must be equal to other")
+}
@@ -0,0 +1,85 @@
+// Package httpvalidator provides helpers to decode and validate HTTP request bodies.
+//
+// The error messages are localized in the language negotiated from
+// the Accept-Language header of the request.
+package httpvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/lufia/go-validator"
+	"github.com/lufia/go-validator/problem"
+)
+
+// DecodeError reports the request body cannot be decoded.
+type DecodeError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	return "httpvalidator: cannot decode the request body: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error of e.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Decode decodes the JSON-encoded body of r into T, then validates it with v.
+//
+// If the body is malformed, Decode returns *DecodeError.
+// Otherwise if the decoded value is invalid, Decode returns the error returned from v.
+func Decode[T any](r *http.Request, v validator.Validator[*T]) (*T, error) {
+	var p T
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+	if err := v.Validate(r.Context(), &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Handler returns the handler that decodes and validates the request body with Decode, then calls fn with the decoded value.
+//
+// When Decode fails, the handler writes the problem details to the response instead of calling fn.
+// Its status is http.StatusBadRequest if the body is malformed,
+// http.StatusInternalServerError if v cannot complete the validation, such as *validator.InternalError,
+// or http.StatusUnprocessableEntity if the decoded value is invalid.
+func Handler[T any](v validator.Validator[*T], fn func(w http.ResponseWriter, r *http.Request, p *T)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := validator.WithAcceptLanguage(r.Context(), r.Header.Get("Accept-Language"))
+		r = r.WithContext(ctx)
+		p, err := Decode(r, v)
+		if err != nil {
+			errorDetails(ctx, err).ServeHTTP(w, r)
+			return
+		}
+		fn(w, r, p)
+	})
+}
+
+func errorDetails(ctx context.Context, err error) *problem.Details {
+	var e *DecodeError
+	if errors.As(err, &e) {
+		return &problem.Details{
+			Title:  http.StatusText(http.StatusBadRequest),
+			Status: http.StatusBadRequest,
+			Detail: e.Err.Error(),
+		}
+	}
+	var ie *validator.InternalError
+	if errors.As(err, &ie) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return &problem.Details{
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+		}
+	}
+	d := problem.New(ctx, err)
+	d.Status = http.StatusUnprocessableEntity
+	return d
+}
@@ -0,0 +1,121 @@
+package httpvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lufia/go-validator"
+)
+
+type request struct {
+	Name string `json:"name"`
+}
+
+var requestValidator = validator.Struct(func(s validator.StructRule, r *request) {
+	validator.AddField(s, &r.Name, "name", validator.Required[string]())
+})
+
+func TestDecode(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/", strings.NewReader(`{"name":"x"}`))
+		p, err := Decode(r, requestValidator)
+		if err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		if p.Name != "x" {
+			t.Errorf("Name = %q; want %q", p.Name, "x")
+		}
+	})
+	t.Run("invalid", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/", strings.NewReader(`{}`))
+		_, err := Decode(r, requestValidator)
+		if err == nil || err.Error() != "name: cannot be the zero value" {
+			t.Errorf("Decode() = %v; want a validation error", err)
+		}
+	})
+	t.Run("malformed", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/", strings.NewReader(`{`))
+		_, err := Decode(r, requestValidator)
+		var e *DecodeError
+		if !errors.As(err, &e) {
+			t.Errorf("Decode() = %v; want *DecodeError", err)
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	h := Handler(requestValidator, func(w http.ResponseWriter, r *http.Request, p *request) {
+		w.Write([]byte(p.Name))
+	})
+	tests := map[string]struct {
+		body     string
+		lang     string
+		code     int
+		response string
+		params   []any
+	}{
+		"valid":     {`{"name":"x"}`, "", http.StatusOK, "x", nil},
+		"malformed": {`{`, "", http.StatusBadRequest, "", nil},
+		"invalid": {`{}`, "", http.StatusUnprocessableEntity, "", []any{
+			map[string]any{"name": "name", "reason": "cannot be the zero value"},
+		}},
+		"ja": {`{}`, "ja-JP,en;q=0.5", http.StatusUnprocessableEntity, "", []any{
+			map[string]any{"name": "name", "reason": "必須です"},
+		}},
+		"unknown": {`{}`, "fr", http.StatusUnprocessableEntity, "", []any{
+			map[string]any{"name": "name", "reason": "cannot be the zero value"},
+		}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/", strings.NewReader(tt.body))
+			if tt.lang != "" {
+				r.Header.Set("Accept-Language", tt.lang)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if w.Code != tt.code {
+				t.Errorf("status = %d; want %d", w.Code, tt.code)
+			}
+			if tt.code == http.StatusOK {
+				if s := w.Body.String(); s != tt.response {
+					t.Errorf("body = %q; want %q", s, tt.response)
+				}
+				return
+			}
+			var m map[string]any
+			if err := json.NewDecoder(w.Body).Decode(&m); err != nil {
+				t.Fatal(err)
+			}
+			if a, _ := m["invalid-params"].([]any); !reflect.DeepEqual(a, tt.params) {
+				t.Errorf("invalid-params = %v; want %v", a, tt.params)
+			}
+		})
+	}
+}
+
+func TestHandler_internalError(t *testing.T) {
+	v := validator.Struct(func(s validator.StructRule, r *request) {
+		validator.AddField(s, &r.Name, "name", validator.NewWithError(func(ctx context.Context, s string) error {
+			return errors.New("connection refused")
+		}))
+	})
+	h := Handler(v, func(w http.ResponseWriter, r *http.Request, p *request) {
+		t.Errorf("handler should not be called")
+	})
+	r := httptest.NewRequest("POST", "/", strings.NewReader(`{"name":"x"}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d; want %d", w.Code, http.StatusInternalServerError)
+	}
+	if s := w.Body.String(); strings.Contains(s, "connection refused") {
+		t.Errorf("body = %q; should not contain the internal error", s)
+	}
+}
@@ -2,13 +2,13 @@ package validator
 
 import (
 	"context"
-	"errors"
 	"slices"
 
 	"golang.org/x/text/message"
 )
 
 // In returns the validator to verify the value is in a.
+// The rule name of its error is "in".
 //
 // Two named args are available in its error format.
 //   - validValues: specified valid values (type []T)
@@ -36,15 +36,20 @@ func (r *inValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T
 // Validate validates v.
 func (r *inValidator[T]) Validate(ctx context.Context, v T) error {
 	if !slices.Contains(r.a, v) {
-		e := &inError[T]{
-			Value:       v,
-			ValidValues: r.a,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *inValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &inError[T]{
+		Value:       v,
+		ValidValues: r.a,
+	}
+	return newRuleError(ctx, "in", e, r.format)
+}
+
 // inError reports an error is caused in In validator.
 type inError[T comparable] struct {
 	Value       T   `arg:"value"`
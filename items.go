@@ -0,0 +1,254 @@
+package validator
+
+import (
+	"context"
+
+	"golang.org/x/text/message"
+)
+
+// MinItems returns the validator to verify the number of elements of the slice is greater or equal than n.
+// The rule name of its error is "minItems".
+//
+// Two named args are available in its error format.
+//   - min: specified min value (type int)
+//   - value: user input (type S)
+func MinItems[S ~[]T, T any](n int) Validator[S] {
+	return &minItemsValidator[S, T]{
+		min:    n,
+		format: minItemsErrorFormat,
+	}
+}
+
+// minItemsValidator represents the validator to check the number of elements is greater or equal than min.
+type minItemsValidator[S ~[]T, T any] struct {
+	min    int
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *minItemsValidator[S, T]) WithFormat(key message.Reference, a ...Arg) Validator[S] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *minItemsValidator[S, T]) Validate(ctx context.Context, v S) error {
+	if len(v) < r.min {
+		return r.ruleError(ctx, v)
+	}
+	return nil
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *minItemsValidator[S, T]) ruleError(ctx context.Context, v S) *RuleError {
+	e := &minItemsError[S]{
+		Min:   r.min,
+		Value: v,
+	}
+	return newRuleError(ctx, "minItems", e, r.format)
+}
+
+// minItemsError reports an error is caused in MinItems validator.
+type minItemsError[S any] struct {
+	Min   int `arg:"min"`
+	Value S   `arg:"value"`
+}
+
+var _ Validator[[]string] = (*minItemsValidator[[]string, string])(nil)
+
+// MaxItems returns the validator to verify the number of elements of the slice is less or equal than n.
+// The rule name of its error is "maxItems".
+//
+// Two named args are available in its error format.
+//   - max: specified max value (type int)
+//   - value: user input (type S)
+func MaxItems[S ~[]T, T any](n int) Validator[S] {
+	return &maxItemsValidator[S, T]{
+		max:    n,
+		format: maxItemsErrorFormat,
+	}
+}
+
+// maxItemsValidator represents the validator to check the number of elements is less or equal than max.
+type maxItemsValidator[S ~[]T, T any] struct {
+	max    int
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *maxItemsValidator[S, T]) WithFormat(key message.Reference, a ...Arg) Validator[S] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *maxItemsValidator[S, T]) Validate(ctx context.Context, v S) error {
+	if len(v) > r.max {
+		return r.ruleError(ctx, v)
+	}
+	return nil
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *maxItemsValidator[S, T]) ruleError(ctx context.Context, v S) *RuleError {
+	e := &maxItemsError[S]{
+		Max:   r.max,
+		Value: v,
+	}
+	return newRuleError(ctx, "maxItems", e, r.format)
+}
+
+// maxItemsError reports an error is caused in MaxItems validator.
+type maxItemsError[S any] struct {
+	Max   int `arg:"max"`
+	Value S   `arg:"value"`
+}
+
+var _ Validator[[]string] = (*maxItemsValidator[[]string, string])(nil)
+
+// ItemsRange returns the validator to verify the number of elements of the slice is within min and max.
+// The rule name of its error is "itemsRange".
+//
+// Three named args are available in its error format.
+//   - min: specified min value (type int)
+//   - max: specified max value (type int)
+//   - value: user input (type S)
+func ItemsRange[S ~[]T, T any](min, max int) Validator[S] {
+	return &itemsRangeValidator[S, T]{
+		min:    min,
+		max:    max,
+		format: itemsRangeErrorFormat,
+	}
+}
+
+// itemsRangeValidator represents the validator to check the number of elements is within min and max.
+type itemsRangeValidator[S ~[]T, T any] struct {
+	min    int
+	max    int
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *itemsRangeValidator[S, T]) WithFormat(key message.Reference, a ...Arg) Validator[S] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *itemsRangeValidator[S, T]) Validate(ctx context.Context, v S) error {
+	if len(v) < r.min || len(v) > r.max {
+		return r.ruleError(ctx, v)
+	}
+	return nil
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *itemsRangeValidator[S, T]) ruleError(ctx context.Context, v S) *RuleError {
+	e := &itemsRangeError[S]{
+		Min:   r.min,
+		Max:   r.max,
+		Value: v,
+	}
+	return newRuleError(ctx, "itemsRange", e, r.format)
+}
+
+// itemsRangeError reports an error is caused in ItemsRange validator.
+type itemsRangeError[S any] struct {
+	Min   int `arg:"min"`
+	Max   int `arg:"max"`
+	Value S   `arg:"value"`
+}
+
+var _ Validator[[]string] = (*itemsRangeValidator[[]string, string])(nil)
+
+// Unique returns the validator to verify the elements of the slice are distinct.
+// The rule name of its error is "unique".
+//
+// Two named args are available in its error format.
+//   - duplicates: the duplicated elements (type []T)
+//   - value: user input (type S)
+func Unique[S ~[]T, T comparable]() Validator[S] {
+	return UniqueFunc[S](func(v T) T {
+		return v
+	})
+}
+
+// UniqueFunc returns the validator to verify the keys of the elements of the slice are distinct.
+// The key of each element is computed with key.
+// The rule name of its error is "unique".
+//
+// Two named args are available in its error format.
+//   - duplicates: the elements that have duplicated keys (type []T)
+//   - value: user input (type S)
+func UniqueFunc[S ~[]T, T any, K comparable](key func(v T) K) Validator[S] {
+	return &uniqueValidator[S, T, K]{
+		key:    key,
+		format: uniqueErrorFormat,
+	}
+}
+
+// uniqueValidator represents the validator to check the elements are distinct.
+type uniqueValidator[S ~[]T, T any, K comparable] struct {
+	key    func(v T) K
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *uniqueValidator[S, T, K]) WithFormat(key message.Reference, a ...Arg) Validator[S] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *uniqueValidator[S, T, K]) Validate(ctx context.Context, v S) error {
+	if a := r.duplicates(v); len(a) > 0 {
+		return r.newError(ctx, v, a)
+	}
+	return nil
+}
+
+// duplicates returns the elements that have duplicated keys.
+// Each of duplicated keys appears at once in the result.
+func (r *uniqueValidator[S, T, K]) duplicates(v S) []T {
+	var a []T
+	seen := make(map[K]int)
+	for _, elem := range v {
+		k := r.key(elem)
+		seen[k]++
+		if seen[k] == 2 {
+			a = append(a, elem)
+		}
+	}
+	return a
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *uniqueValidator[S, T, K]) ruleError(ctx context.Context, v S) *RuleError {
+	return r.newError(ctx, v, r.duplicates(v))
+}
+
+// newError returns the error that reports v contains duplicates.
+// If duplicates is empty, such as in Not validator, the default message does not list them.
+func (r *uniqueValidator[S, T, K]) newError(ctx context.Context, v S, duplicates []T) *RuleError {
+	e := &uniqueError[S, T]{
+		Duplicates: duplicates,
+		Value:      v,
+	}
+	format := r.format
+	if len(duplicates) == 0 && format == uniqueErrorFormat {
+		format = uniqueItemsFormat
+	}
+	return newRuleError(ctx, "unique", e, format)
+}
+
+// uniqueError reports an error is caused in Unique validator.
+type uniqueError[S ~[]T, T any] struct {
+	Duplicates []T `arg:"duplicates"`
+	Value      S   `arg:"value"`
+}
+
+var _ Validator[[]string] = (*uniqueValidator[[]string, string, string])(nil)
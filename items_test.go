@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMinItems(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := MinItems[[]string](2)
+		testValidate(t, v, []string{"a", "b"}, "")
+		testValidate(t, v, []string{"a", "b", "c"}, "")
+		testValidate(t, v, []string{"a"}, "the number of items must be no less than 2")
+		testValidate(t, v, nil, "the number of items must be no less than 2")
+	})
+}
+
+func TestMinItemsWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := MinItems[[]string](2).WithFormat("less than %v", ByName("min"))
+		testValidate(t, v, []string{"a"}, "less than 2")
+	})
+}
+
+func TestMaxItems(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := MaxItems[[]string](2)
+		testValidate(t, v, nil, "")
+		testValidate(t, v, []string{"a", "b"}, "")
+		testValidate(t, v, []string{"a", "b", "c"}, "the number of items must be no greater than 2")
+	})
+}
+
+func TestMaxItemsWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := MaxItems[[]string](2).WithFormat("greater than %v", ByName("max"))
+		testValidate(t, v, []string{"a", "b", "c"}, "greater than 2")
+	})
+}
+
+func TestItemsRange(t *testing.T) {
+	type Tags []string
+	t.Run("named", func(t *testing.T) {
+		v := ItemsRange[Tags](1, 2)
+		testValidate(t, v, Tags{"a"}, "")
+		testValidate(t, v, Tags{"a", "b"}, "")
+		testValidate(t, v, Tags{}, "the number of items must be in range(1 ... 2)")
+		testValidate(t, v, Tags{"a", "b", "c"}, "the number of items must be in range(1 ... 2)")
+	})
+}
+
+func TestItemsRangeWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := ItemsRange[[]string](1, 2).WithFormat("out of range(%v, %v)", ByName("min"), ByName("max"))
+		testValidate(t, v, nil, "out of range(1, 2)")
+	})
+}
+
+func TestUnique(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Unique[[]string]()
+		testValidate(t, v, nil, "")
+		testValidate(t, v, []string{"a", "b"}, "")
+		testValidate(t, v, []string{"a", "b", "a", "a", "b", "c"}, "must not contain duplicate items [a b]")
+	})
+}
+
+func TestUnique_not(t *testing.T) {
+	v := Not(Unique[[]string]())
+	testValidate(t, v, []string{"a", "a"}, "")
+	testValidate(t, v, []string{"a", "b"}, "must not satisfy: must not contain duplicate items")
+}
+
+func TestUniqueFunc(t *testing.T) {
+	type User struct {
+		Name string
+	}
+	t.Run("struct", func(t *testing.T) {
+		v := UniqueFunc[[]User](func(u User) string {
+			return strings.ToLower(u.Name)
+		})
+		testValidate(t, v, []User{{"alice"}, {"bob"}}, "")
+		testValidate(t, v, []User{{"alice"}, {"bob"}, {"Alice"}}, "must not contain duplicate items [{Alice}]")
+	})
+}
@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"context"
+	"slices"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+type languageKey struct{}
+
+// WithLanguage returns a copy of ctx with the Printer for the language that is best matched to tags
+// among the languages available in DefaultCatalog.
+// The matched language is also available with LanguageFrom.
+//
+// If none of tags are matched, WithLanguage returns ctx as is.
+func WithLanguage(ctx context.Context, tags ...language.Tag) context.Context {
+	if len(tags) == 0 {
+		return ctx
+	}
+	supported := supportedLanguages()
+	_, i, conf := language.NewMatcher(supported).Match(tags...)
+	if conf == language.No {
+		return ctx
+	}
+	p := message.NewPrinter(supported[i], message.Catalog(DefaultCatalog))
+	ctx = context.WithValue(ctx, languageKey{}, supported[i])
+	return WithPrinter(ctx, p)
+}
+
+// LanguageFrom returns the language that is set to ctx with WithLanguage or WithAcceptLanguage.
+// If ctx does not have any language, LanguageFrom returns the default language, English.
+//
+// It is useful to localize the messages that are not registered in DefaultCatalog
+// in the same language as the validators.
+func LanguageFrom(ctx context.Context) language.Tag {
+	if tag, ok := ctx.Value(languageKey{}).(language.Tag); ok {
+		return tag
+	}
+	return defaultLanguage
+}
+
+// WithAcceptLanguage is like WithLanguage but takes the value of Accept-Language header.
+//
+// If s is malformed, WithAcceptLanguage returns ctx as is.
+func WithAcceptLanguage(ctx context.Context, s string) context.Context {
+	tags, _, err := language.ParseAcceptLanguage(s)
+	if err != nil {
+		return ctx
+	}
+	return WithLanguage(ctx, tags...)
+}
+
+// supportedLanguages returns the languages in DefaultCatalog.
+// The first language is always the default language.
+func supportedLanguages() []language.Tag {
+	a := []language.Tag{defaultLanguage}
+	for _, tag := range DefaultCatalog.Languages() {
+		if !slices.Contains(a, tag) {
+			a = append(a, tag)
+		}
+	}
+	return a
+}
@@ -0,0 +1,57 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestWithLanguage(t *testing.T) {
+	tests := map[string]struct {
+		tags []language.Tag
+		want string
+		lang language.Tag
+	}{
+		"none":     {nil, "cannot be the zero value", language.English},
+		"english":  {[]language.Tag{language.AmericanEnglish}, "cannot be the zero value", language.English},
+		"japanese": {[]language.Tag{language.Japanese}, "必須です", language.Japanese},
+		"priority": {[]language.Tag{language.French, language.Japanese, language.English}, "必須です", language.Japanese},
+		"unknown":  {[]language.Tag{language.French}, "cannot be the zero value", language.English},
+	}
+	v := Required[string]()
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := WithLanguage(context.Background(), tt.tags...)
+			err := v.Validate(ctx, "")
+			if s := err.Error(); s != tt.want {
+				t.Errorf("Validate() = %q; want %q", s, tt.want)
+			}
+			if tag := LanguageFrom(ctx); tag != tt.lang {
+				t.Errorf("LanguageFrom() = %v; want %v", tag, tt.lang)
+			}
+		})
+	}
+}
+
+func TestWithAcceptLanguage(t *testing.T) {
+	tests := map[string]struct {
+		s    string
+		want string
+	}{
+		"empty":     {"", "cannot be the zero value"},
+		"japanese":  {"ja-JP,en-US;q=0.8", "必須です"},
+		"quality":   {"ja;q=0.5,en;q=0.8", "cannot be the zero value"},
+		"malformed": {";;;", "cannot be the zero value"},
+	}
+	v := Required[string]()
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := WithAcceptLanguage(context.Background(), tt.s)
+			err := v.Validate(ctx, "")
+			if s := err.Error(); s != tt.want {
+				t.Errorf("Validate() = %q; want %q", s, tt.want)
+			}
+		})
+	}
+}
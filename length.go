@@ -2,12 +2,12 @@ package validator
 
 import (
 	"context"
-	"errors"
 
 	"golang.org/x/text/message"
 )
 
 // MinLength returns the validator to verify the length of the value is greater or equal than n.
+// The rule name of its error is "minLength".
 //
 // Two named args are available in its error format.
 //   - min: specified min value (type int)
@@ -36,15 +36,20 @@ func (r *minLengthValidator[T]) WithFormat(key message.Reference, a ...Arg) Vali
 func (r *minLengthValidator[T]) Validate(ctx context.Context, v T) error {
 	a := []rune(v)
 	if len(a) < r.min {
-		e := &minLengthError[T]{
-			Value: v,
-			Min:   r.min,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *minLengthValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &minLengthError[T]{
+		Value: v,
+		Min:   r.min,
+	}
+	return newRuleError(ctx, "minLength", e, r.format)
+}
+
 // minLengthError reports an error is caused in MinLength validator.
 type minLengthError[T ~string] struct {
 	Min   int `arg:"min"`
@@ -54,6 +59,7 @@ type minLengthError[T ~string] struct {
 var _ Validator[string] = (*minLengthValidator[string])(nil)
 
 // MaxLength returns the validator to verify the length of the value is less or equal than n.
+// The rule name of its error is "maxLength".
 //
 // Two named args are available in its error format.
 //   - max: specified max value (type int)
@@ -82,15 +88,20 @@ func (r *maxLengthValidator[T]) WithFormat(key message.Reference, a ...Arg) Vali
 func (r *maxLengthValidator[T]) Validate(ctx context.Context, v T) error {
 	a := []rune(v)
 	if len(a) > r.max {
-		e := &maxLengthError[T]{
-			Value: v,
-			Max:   r.max,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *maxLengthValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &maxLengthError[T]{
+		Value: v,
+		Max:   r.max,
+	}
+	return newRuleError(ctx, "maxLength", e, r.format)
+}
+
 // maxLengthError reports an error is caused in MaxLength validator.
 type maxLengthError[T ~string] struct {
 	Max   int `arg:"max"`
@@ -100,6 +111,7 @@ type maxLengthError[T ~string] struct {
 var _ Validator[string] = (*maxLengthValidator[string])(nil)
 
 // Length returns the validator to verify the length of the value is within min and max.
+// The rule name of its error is "length".
 //
 // Three named args are available in its error format.
 //   - min: specified min value (type int)
@@ -131,16 +143,21 @@ func (r *lengthValidator[T]) WithFormat(key message.Reference, a ...Arg) Validat
 func (r *lengthValidator[T]) Validate(ctx context.Context, v T) error {
 	a := []rune(v)
 	if len(a) < r.min || len(a) > r.max {
-		e := &lengthError[T]{
-			Min:   r.min,
-			Max:   r.max,
-			Value: v,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *lengthValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &lengthError[T]{
+		Min:   r.min,
+		Max:   r.max,
+		Value: v,
+	}
+	return newRuleError(ctx, "length", e, r.format)
+}
+
 // lengthError reports an error is caused in Length validator.
 type lengthError[T ~string] struct {
 	Min   int `arg:"min"`
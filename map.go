@@ -1,3 +1,170 @@
 package validator
 
-// TODO: implement Map(build func(...))
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"golang.org/x/text/message"
+)
+
+// Map returns the validator to verify a map.
+// Each key of the map is verified with kv, and each value is verified with vv.
+// Either kv or vv can be nil to skip it.
+//
+// Four named args are available in its error format.
+//   - key: the key of the entry (type K)
+//   - value: the value of the entry (type V)
+//   - part: "key" if the key is invalid, or "value" if the value is invalid (type string)
+//   - error: occurred validation error(s) (type error)
+//
+// By default, the errors for the keys and for the values are formatted in different messages.
+// Once WithFormat is called, its format is used for both of them; use the part arg to distinguish them.
+func Map[K comparable, V any](kv Validator[K], vv Validator[V]) Validator[map[K]V] {
+	return &mapValidator[map[K]V, K, V]{
+		kv:          kv,
+		vv:          vv,
+		keyFormat:   mapKeyErrorFormat,
+		valueFormat: mapValueErrorFormat,
+	}
+}
+
+// mapValidator represents the validator to check map entries.
+type mapValidator[M ~map[K]V, K comparable, V any] struct {
+	kv          Validator[K]
+	vv          Validator[V]
+	keyFormat   *errorFormat
+	valueFormat *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *mapValidator[M, K, V]) WithFormat(key message.Reference, a ...Arg) Validator[M] {
+	rr := *r
+	rr.keyFormat = &errorFormat{Key: key, Args: a}
+	rr.valueFormat = rr.keyFormat
+	return &rr
+}
+
+// Validate validates v.
+func (r *mapValidator[M, K, V]) Validate(ctx context.Context, v M) error {
+	var m OrderedMap[K, error]
+	for _, key := range sortedKeys(v) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		elem := v[key]
+		var errs []error
+		if r.kv != nil {
+			err := r.kv.Validate(ctx, key)
+			if err := abortError(ctx, err); err != nil {
+				return err
+			}
+			if err != nil {
+				errs = append(errs, r.wrapError(ctx, PathMapKey{key}, "key", key, elem, err, r.keyFormat))
+			}
+		}
+		if r.vv != nil {
+			err := r.vv.Validate(ctx, elem)
+			if err := abortError(ctx, err); err != nil {
+				return err
+			}
+			if err != nil {
+				errs = append(errs, r.wrapError(ctx, PathKey{key}, "value", key, elem, err, r.valueFormat))
+			}
+		}
+		if len(errs) > 0 {
+			m.set(key, joinErrors(errs...))
+		}
+	}
+	if m.Len() > 0 {
+		return &MapError[M, K, V]{
+			Value:  v,
+			Errors: &m,
+		}
+	}
+	return nil
+}
+
+func (r *mapValidator[M, K, V]) wrapError(ctx context.Context, elem PathElement, part string, key K, v V, err error, format *errorFormat) error {
+	return wrapErrors(err, func(err error) error {
+		e := &mapEntryError[K, V]{
+			Key:   key,
+			Value: v,
+			Part:  part,
+			Err:   err,
+		}
+		return withPath(elem, err, ctxPrint(ctx, e, format.Key, format.Args))
+	})
+}
+
+// sortedKeys returns keys of m in stable order.
+func sortedKeys[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, compareKeys)
+	return keys
+}
+
+// compareKeys compares a and b in their natural order if they are numbers or strings.
+// Otherwise it compares their string representations.
+func compareKeys[K comparable](a, b K) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == vb.Kind() {
+		switch va.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(va.Int(), vb.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(va.Uint(), vb.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(va.Float(), vb.Float())
+		case reflect.String:
+			return cmp.Compare(va.String(), vb.String())
+		}
+	}
+	if c := cmp.Compare(fmt.Sprint(a), fmt.Sprint(b)); c != 0 {
+		return c
+	}
+	return cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+}
+
+// mapEntryError reports an error is caused in Map validator.
+type mapEntryError[K comparable, V any] struct {
+	Key   K      `arg:"key"`
+	Value V      `arg:"value"`
+	Part  string `arg:"part"`
+	Err   error  `arg:"error"`
+}
+
+// MapError reports an error is caused in Map validator.
+type MapError[M ~map[K]V, K comparable, V any] struct {
+	Value  M
+	Errors *OrderedMap[K, error]
+}
+
+// Error implements the error interface.
+func (e MapError[M, K, V]) Error() string {
+	return joinErrors(e.Unwrap()...).Error()
+}
+
+// Unwrap returns each errors of err.
+func (e MapError[M, K, V]) Unwrap() []error {
+	n := e.Errors.Len()
+	if n == 0 {
+		return nil
+	}
+	errs := make([]error, 0, n)
+	for _, key := range e.Errors.Keys() {
+		err, _ := e.Errors.Get(key)
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+var (
+	_ Validator[map[string]any] = (*mapValidator[map[string]any, string, any])(nil)
+	_ Error                     = (*MapError[map[string]any, string, any])(nil)
+)
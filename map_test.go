@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Map(MinLength[string](2), Required[string]())
+		testValidate(t, v, map[string]string{"ab": "x"}, "")
+		testValidate(t, v, map[string]string(nil), "")
+		testValidate(t, v, map[string]string{"ab": ""}, "ab: cannot be the zero value")
+		testValidate(t, v, map[string]string{"a": "x"}, "key a: the length must be no less than 2")
+		testValidate(t, v, map[string]string{"a": ""}, "key a: the length must be no less than 2\na: cannot be the zero value")
+	})
+	t.Run("order", func(t *testing.T) {
+		v := Map[string](nil, Min(0))
+		testValidate(t, v, map[string]int{"c": -1, "a": -1, "b": 1}, "a: must be no less than 0\nc: must be no less than 0")
+	})
+	t.Run("int", func(t *testing.T) {
+		v := Map[int](nil, Min(0))
+		testValidate(t, v, map[int]int{10: -1, 2: -1, 1: -1}, "1: must be no less than 0\n2: must be no less than 0\n10: must be no less than 0")
+	})
+	t.Run("interface", func(t *testing.T) {
+		v := Map[any](nil, Min(0))
+		for range 10 {
+			err := v.Validate(t.Context(), map[any]int{1: -1, "1": -1})
+			e := err.(*MapError[map[any]int, any, int])
+			if keys := e.Errors.Keys(); len(keys) != 2 || keys[0] != "1" || keys[1] != 1 {
+				t.Fatalf("Keys() = %#v; want [\"1\" 1]", keys)
+			}
+		}
+	})
+}
+
+func TestMapWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Map(MinLength[string](2), Required[string]()).WithFormat("%v is invalid", ByName("key"))
+		testValidate(t, v, map[string]string{"a": "x"}, "a is invalid")
+		testValidate(t, v, map[string]string{"ab": ""}, "ab is invalid")
+	})
+	t.Run("part", func(t *testing.T) {
+		v := Map(MinLength[string](2), Required[string]()).WithFormat("%v of %v is invalid", ByName("part"), ByName("key"))
+		testValidate(t, v, map[string]string{"a": "x"}, "key of a is invalid")
+		testValidate(t, v, map[string]string{"ab": ""}, "value of ab is invalid")
+	})
+}
+
+func TestMapError(t *testing.T) {
+	v := Map[string](nil, Min(0))
+	err := v.Validate(t.Context(), map[string]int{"b": -1, "a": -2})
+	e, ok := err.(*MapError[map[string]int, string, int])
+	if !ok {
+		t.Fatalf("Validate() = %T; want *MapError", err)
+	}
+	if keys := e.Errors.Keys(); len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Errorf("Keys() = %v; want [a b]", keys)
+	}
+}
@@ -0,0 +1,95 @@
+package validator
+
+import (
+	"context"
+
+	"golang.org/x/text/message"
+)
+
+// Not returns the validator to verify the value does not satisfy v.
+// The rule name of its error is "not".
+//
+// Three named args are available in its error format.
+//   - description: the localized description of the rule of v (type string)
+//   - rule: the rule name of v (type string)
+//   - value: user input (type T)
+//
+// The description is the same message as the error that v returns.
+// If v is Or, Join, Chain, When, Unless or Optional, the description is composed of the descriptions of its validators;
+// the rule name of Join and Chain is "join" if they have multiple validators.
+// If v is other composition validator, such as Struct or Slice, the description is the message of New's default
+// and the rule name is "custom".
+func Not[T any](v Validator[T]) Validator[T] {
+	return &notValidator[T]{
+		v:      v,
+		format: notErrorFormat,
+	}
+}
+
+// notValidator represents the validator to check the value does not satisfy v.
+type notValidator[T any] struct {
+	v      Validator[T]
+	format *errorFormat
+}
+
+// ruler is the interface that is implemented by the builtin validators.
+type ruler[T any] interface {
+	ruleError(ctx context.Context, v T) *RuleError
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *notValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *notValidator[T]) Validate(ctx context.Context, v T) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	err := r.v.Validate(ctx, v)
+	if err := abortError(ctx, err); err != nil {
+		return err
+	}
+	if err != nil {
+		return nil
+	}
+	return r.ruleError(ctx, v)
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *notValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &notError[T]{
+		Value: v,
+	}
+	err := ruleErrorOf(ctx, r.v, v)
+	e.Description = err.Error()
+	e.Rule = err.Rule
+	return newRuleError(ctx, "not", e, r.format)
+}
+
+// ruleErrorOf returns the error that reports v does not satisfy p.
+// If p is not a ruler, ruleErrorOf returns the error of "custom" rule with New's default format.
+func ruleErrorOf[T any](ctx context.Context, p Validator[T], v T) *RuleError {
+	if p, ok := p.(ruler[T]); ok {
+		return p.ruleError(ctx, v)
+	}
+	e := &customError[T]{
+		Value: v,
+	}
+	return newRuleError(ctx, "custom", e, customErrorFormat)
+}
+
+// notError reports an error is caused in Not validator.
+type notError[T any] struct {
+	Description string `arg:"description"`
+	Rule        string `arg:"rule"`
+	Value       T      `arg:"value"`
+}
+
+var (
+	_ Validator[string] = (*notValidator[string])(nil)
+	_ ruler[string]     = (*notValidator[string])(nil)
+)
@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestNot(t *testing.T) {
+	t.Run("in", func(t *testing.T) {
+		v := Not(In("root", "admin"))
+		testValidate(t, v, "alice", "")
+		testValidate(t, v, "root", "must not satisfy: must be a valid value in [root admin]")
+	})
+	t.Run("pattern", func(t *testing.T) {
+		v := Not(PatternString[string]("^_"))
+		testValidate(t, v, "a", "")
+		testValidate(t, v, "_a", "must not satisfy: must match the pattern /^_/")
+	})
+	t.Run("custom", func(t *testing.T) {
+		v := Not(New(func(ctx context.Context, n int) bool { return n%2 == 0 }))
+		testValidate(t, v, 1, "")
+		testValidate(t, v, 2, "must not satisfy: must be a valid value")
+	})
+	t.Run("or", func(t *testing.T) {
+		v := Not(Or(In("root"), PatternString[string]("^_")))
+		testValidate(t, v, "alice", "")
+		testValidate(t, v, "_a", "must not satisfy: must satisfy one of: must be a valid value in [root]; must match the pattern /^_/")
+	})
+	t.Run("orWithFormat", func(t *testing.T) {
+		v := Not(Or(In("root"), In("admin")).WithFormat("must be root or admin"))
+		testValidate(t, v, "root", "must not satisfy: must be root or admin")
+	})
+	t.Run("join", func(t *testing.T) {
+		v := Not(Join(Min(3), Max(5)))
+		testValidate(t, v, 2, "")
+		testValidate(t, v, 4, "must not satisfy: must satisfy all of: must be no less than 3; must be no greater than 5")
+	})
+	t.Run("chain", func(t *testing.T) {
+		v := Not(Chain(Min(3)))
+		testValidate(t, v, 2, "")
+		testValidate(t, v, 4, "must not satisfy: must be no less than 3")
+	})
+	t.Run("optional", func(t *testing.T) {
+		v := Not(Optional(In("root")))
+		testValidate(t, v, "alice", "")
+		testValidate(t, v, "root", "must not satisfy: must be a valid value in [root]")
+	})
+	t.Run("double", func(t *testing.T) {
+		v := Not(Not(Min(3)))
+		testValidate(t, v, 3, "")
+		testValidate(t, v, 2, "must not satisfy: must not satisfy: must be no less than 3")
+	})
+	t.Run("localized", func(t *testing.T) {
+		ctx := WithLanguage(context.Background(), language.Japanese)
+		err := Not(In("root")).Validate(ctx, "root")
+		if want := "次の条件を満たしてはいけません: [root]のいずれかでなければなりません"; err == nil || err.Error() != want {
+			t.Errorf("Validate() = %v; want %s", err, want)
+		}
+	})
+}
+
+func TestNotWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Not(In("root", "admin")).WithFormat("%v is reserved (%v)", ByName("value"), ByName("rule"))
+		testValidate(t, v, "root", "root is reserved (in)")
+	})
+}
+
+func TestNotRuleError(t *testing.T) {
+	err := Not(Min(3)).Validate(context.Background(), 5)
+	var e *RuleError
+	if !errors.As(err, &e) {
+		t.Fatalf("errors.As(%v) = false", err)
+	}
+	if e.Rule != "not" || e.Params["rule"] != "min" {
+		t.Errorf("Rule = %q, Params = %v; want not and min", e.Rule, e.Params)
+	}
+
+	tests := map[string]struct {
+		v    Validator[int]
+		rule string
+	}{
+		"or":     {Or(Min(3), Max(0)), "or"},
+		"join":   {Join(Min(3), Max(5)), "join"},
+		"chain":  {Chain(Min(3), Max(5)), "join"},
+		"when":   {When(func(ctx context.Context, n int) bool { return true }, Min(3)), "min"},
+		"custom": {New(func(ctx context.Context, n int) bool { return true }), "custom"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := Not(tt.v).Validate(context.Background(), 4)
+			var e *RuleError
+			if !errors.As(err, &e) || e.Params["rule"] != tt.rule {
+				t.Errorf("Validate(4) = %v; want the rule %q", err, tt.rule)
+			}
+		})
+	}
+	t.Run("pointer", func(t *testing.T) {
+		n := 4
+		err := Not(Pointer(Min(3))).Validate(context.Background(), &n)
+		var e *RuleError
+		if !errors.As(err, &e) || e.Params["rule"] != "custom" || e.Params["description"] != "must be a valid value" {
+			t.Errorf("Validate(&4) = %v; want the rule custom", err)
+		}
+	})
+}
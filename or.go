@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"golang.org/x/text/message"
+)
+
+// Or returns the validator to verify the value satisfies at least one of vs.
+// If vs is empty, the validator always passes.
+// The rule name of its error is "or".
+//
+// Two named args are available in its error format.
+//   - errors: occurred validation errors of each alternative (type []error)
+//   - value: user input (type T)
+func Or[T any](vs ...Validator[T]) Validator[T] {
+	var a []Validator[T]
+	for _, v := range vs {
+		if p, ok := v.(*orValidator[T]); ok && p.format == orErrorFormat {
+			a = append(a, p.vs...)
+		} else {
+			a = append(a, v)
+		}
+	}
+	return &orValidator[T]{
+		vs:     a,
+		format: orErrorFormat,
+	}
+}
+
+// orValidator represents the validator to check the value satisfies one of vs.
+type orValidator[T any] struct {
+	vs     []Validator[T]
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *orValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *orValidator[T]) Validate(ctx context.Context, v T) error {
+	if len(r.vs) == 0 {
+		return nil
+	}
+	var errs errorList
+	for _, p := range r.vs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := p.Validate(ctx, v)
+		if err := abortError(ctx, err); err != nil {
+			return err
+		}
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, flattenErrors(err)...)
+	}
+	e := &orError[T]{
+		Errors: errs,
+		Value:  v,
+	}
+	return newRuleError(ctx, "or", e, r.format)
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+// Its errors are the errors that report v does not satisfy each validator of r.
+func (r *orValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	errs := make(errorList, len(r.vs))
+	for i, p := range r.vs {
+		errs[i] = ruleErrorOf(ctx, p, v)
+	}
+	e := &orError[T]{
+		Errors: errs,
+		Value:  v,
+	}
+	return newRuleError(ctx, "or", e, r.format)
+}
+
+// orError reports an error is caused in Or validator.
+type orError[T any] struct {
+	Errors errorList `arg:"errors"`
+	Value  T         `arg:"value"`
+}
+
+// errorList is the list of errors that is formatted in a line.
+type errorList []error
+
+// String returns the messages of a joined with semicolons.
+func (a errorList) String() string {
+	s := make([]string, len(a))
+	for i, err := range a {
+		s[i] = err.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It is encoded as the list of the messages.
+func (a errorList) MarshalJSON() ([]byte, error) {
+	s := make([]string, len(a))
+	for i, err := range a {
+		s[i] = err.Error()
+	}
+	return json.Marshal(s)
+}
+
+var (
+	_ Validator[string] = (*orValidator[string])(nil)
+	_ ruler[string]     = (*orValidator[string])(nil)
+)
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOr(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Or(In("a", "b"), PatternString[string]("^x+$"))
+		testValidate(t, v, "a", "")
+		testValidate(t, v, "xx", "")
+		testValidate(t, v, "c", "must satisfy one of: must be a valid value in [a b]; must match the pattern /^x+$/")
+	})
+	t.Run("nested", func(t *testing.T) {
+		v := Or(Min(10), Or(Max(0), In(5)))
+		if n := len(v.(*orValidator[int]).vs); n != 3 {
+			t.Errorf("got %d; want 3", n)
+		}
+		testValidate(t, v, 5, "")
+		testValidate(t, v, 3, "must satisfy one of: must be no less than 10; must be no greater than 0; must be a valid value in [5]")
+	})
+	t.Run("empty", func(t *testing.T) {
+		v := Or[int]()
+		testValidate(t, v, 1, "")
+	})
+}
+
+func TestOrWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Or(In("a"), In("b")).WithFormat("%v is not a or b", ByName("value"))
+		testValidate(t, v, "c", "c is not a or b")
+	})
+	t.Run("nested", func(t *testing.T) {
+		v := Or(Or(In("a"), In("b")).WithFormat("must be a or b"), In("c"))
+		if n := len(v.(*orValidator[string]).vs); n != 2 {
+			t.Errorf("got %d; want 2", n)
+		}
+		testValidate(t, v, "b", "")
+		testValidate(t, v, "d", "must satisfy one of: must be a or b; must be a valid value in [c]")
+	})
+}
+
+func TestOrMarshalErrors(t *testing.T) {
+	err := Or(In("a"), In("b")).Validate(context.Background(), "c")
+	b, err := MarshalErrors(err)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"path":"","rule":"or","message":"must satisfy one of: must be a valid value in [a]; must be a valid value in [b]","params":{"errors":["must be a valid value in [a]","must be a valid value in [b]"]}}]`
+	if s := string(b); s != want {
+		t.Errorf("MarshalErrors() = %s; want %s", s, want)
+	}
+}
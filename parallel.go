@@ -0,0 +1,95 @@
+package validator
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Parallel returns the validator that validates the elements or the fields of the value concurrently
+// with at most n goroutines. If n < 1, it is set to runtime.GOMAXPROCS(0).
+//
+// Parallel supports the validators returned from Array, Slice, SliceOf and Struct.
+// Even though they are validated concurrently, the order of the errors is the same as the sequential validation.
+// For other validators, Parallel returns v as is.
+//
+// The validators of the elements or the fields are called from multiple goroutines at the same time.
+// Therefore they, including the functions passed to New and NewWithError, must be safe for concurrent use.
+// The struct-level rules added with AddRule are called sequentially after the fields are validated.
+//
+// If a validator panics, the panic is propagated to the goroutine calling Validate
+// after the other running validators return.
+func Parallel[T any](v Validator[T], n int) Validator[T] {
+	if n < 1 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	if p, ok := v.(parallelizer[T]); ok {
+		return p.parallel(n)
+	}
+	return v
+}
+
+// parallelizer is the interface that is implemented by the validators supporting Parallel.
+type parallelizer[T any] interface {
+	parallel(n int) Validator[T]
+}
+
+// forEach calls fn with each index in [0, n) until fn returns false or ctx is done.
+//
+// If workers is zero, fn is called sequentially.
+// Otherwise fn is called concurrently in at most workers goroutines.
+// In both cases, fn is started in the index order, and it is not started
+// for the indexes after any fn returned false.
+// If fn panics in a goroutine, forEach panics with the same value after all the running fn return.
+func forEach(ctx context.Context, n, workers int, fn func(i int) bool) {
+	if workers == 0 {
+		for i := range n {
+			if ctx.Err() != nil || !fn(i) {
+				return
+			}
+		}
+		return
+	}
+
+	var (
+		wg   sync.WaitGroup
+		stop atomic.Bool
+		sem  = make(chan struct{}, workers)
+
+		mu       sync.Mutex
+		panicked bool
+		pv       any
+	)
+	for i := range n {
+		sem <- struct{}{}
+		if ctx.Err() != nil || stop.Load() {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			defer func() {
+				if e := recover(); e != nil {
+					mu.Lock()
+					if !panicked {
+						panicked, pv = true, e
+					}
+					mu.Unlock()
+					stop.Store(true)
+				}
+			}()
+			if !fn(i) {
+				stop.Store(true)
+			}
+		}()
+	}
+	wg.Wait()
+	if panicked {
+		panic(pv)
+	}
+}
@@ -0,0 +1,152 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallel(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		v := Parallel(Slice(Min(3)), 4)
+		testValidate(t, v, []int{3, 4, 5}, "")
+		testValidate(t, v, []int{5, 2, 4, 1, 0}, "index 1: must be no less than 3\nindex 3: must be no less than 3\nindex 4: must be no less than 3")
+	})
+	t.Run("array", func(t *testing.T) {
+		v := Parallel(Array[[4]int](Min(3)), 2)
+		testValidate(t, v, [4]int{3, 2, 1, 4}, "index 1: must be no less than 3\nindex 2: must be no less than 3")
+	})
+	t.Run("struct", func(t *testing.T) {
+		type Request struct {
+			A string
+			B string
+			C string
+		}
+		v := Parallel(Struct(func(s StructRule, r *Request) {
+			AddField(s, &r.A, "a", Required[string]())
+			AddField(s, &r.B, "b", Required[string]())
+			AddField(s, &r.C, "c", Required[string]())
+		}), 3)
+		testValidate(t, v, &Request{B: "b"}, "a: cannot be the zero value\nc: cannot be the zero value")
+	})
+	t.Run("unsupported", func(t *testing.T) {
+		v := Min(3)
+		if p := Parallel(v, 2); p != v {
+			t.Errorf("Parallel(%v) = %v; want as is", v, p)
+		}
+	})
+}
+
+func TestParallel_order(t *testing.T) {
+	// The earlier elements finish later.
+	v := Parallel(Slice(New(func(ctx context.Context, i int) bool {
+		time.Sleep(time.Duration(10-i) * time.Millisecond)
+		return i%2 == 0
+	})), 0)
+	err := v.Validate(context.Background(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	var e *SliceError[[]int, int]
+	if !errors.As(err, &e) {
+		t.Fatalf("Validate() = %v; want *SliceError", err)
+	}
+	want := []int{1, 3, 5, 7, 9}
+	if keys := e.Errors.Keys(); !slices.Equal(keys, want) {
+		t.Errorf("Keys() = %v; want %v", keys, want)
+	}
+}
+
+func TestParallel_workers(t *testing.T) {
+	var running, peak atomic.Int32
+	v := Parallel(Slice(New(func(ctx context.Context, i int) bool {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := peak.Load()
+			if n <= m || peak.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return true
+	})), 3)
+	if err := v.Validate(context.Background(), make([]int, 20)); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+	if n := peak.Load(); n > 3 {
+		t.Errorf("%d goroutines ran at the same time; want at most 3", n)
+	}
+}
+
+func TestParallel_failFast(t *testing.T) {
+	type Request struct {
+		A string
+		B string
+		C string
+	}
+	v := Parallel(Struct(func(s StructRule, r *Request) {
+		FailFast(s)
+		AddField(s, &r.A, "a", New(func(ctx context.Context, s string) bool {
+			time.Sleep(10 * time.Millisecond)
+			return s != ""
+		}))
+		AddField(s, &r.B, "b", Required[string]())
+		AddField(s, &r.C, "c", Required[string]())
+	}), 3)
+	testValidate(t, v, &Request{}, "a: must be a valid value")
+	testValidate(t, v, &Request{A: "a"}, "b: cannot be the zero value")
+}
+
+func TestParallel_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var n atomic.Int32
+	v := Parallel(Slice(New(func(ctx context.Context, i int) bool {
+		n.Add(1)
+		if i == 2 {
+			cancel()
+		}
+		return false
+	})), 1)
+	err := v.Validate(ctx, []int{0, 1, 2, 3, 4})
+	if err != context.Canceled {
+		t.Errorf("Validate() = %v; want %v", err, context.Canceled)
+	}
+	if n := n.Load(); n != 3 {
+		t.Errorf("validated %d elements; want 3", n)
+	}
+}
+
+func TestParallel_failFastInternalError(t *testing.T) {
+	type Request struct {
+		A string
+		B string
+	}
+	v := Parallel(Struct(func(s StructRule, r *Request) {
+		FailFast(s)
+		AddField(s, &r.A, "a", New(func(ctx context.Context, s string) bool {
+			time.Sleep(10 * time.Millisecond)
+			return false
+		}))
+		AddField(s, &r.B, "b", NewWithError(func(ctx context.Context, s string) error {
+			return errors.New("lookup failed")
+		}))
+	}), 2)
+	testValidate(t, v, &Request{}, "a: must be a valid value")
+}
+
+func TestParallel_panic(t *testing.T) {
+	v := Parallel(Slice(New(func(ctx context.Context, i int) bool {
+		if i == 1 {
+			panic("oops")
+		}
+		return true
+	})), 2)
+	defer func() {
+		if e := recover(); e != "oops" {
+			t.Errorf("recover() = %v; want oops", e)
+		}
+	}()
+	v.Validate(context.Background(), []int{0, 1, 2})
+}
@@ -0,0 +1,207 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Path represents the location of a value in the validated value.
+// For example, the Path of "user.addresses[2].zip" is:
+//
+//	Path{PathField("user"), PathField("addresses"), PathIndex(2), PathField("zip")}
+type Path []PathElement
+
+// PathElement is an element of Path.
+// It is one of PathField, PathIndex, PathKey or PathMapKey.
+type PathElement interface {
+	pathElement()
+}
+
+// PathField is the name of a struct field that is registered with AddField.
+type PathField string
+
+// PathIndex is the index of a slice element.
+type PathIndex int
+
+// PathKey is the key of a map entry. It locates the value of the entry.
+type PathKey struct {
+	Key any
+}
+
+// PathMapKey is the key of a map entry. Unlike PathKey, it locates the key itself.
+type PathMapKey struct {
+	Key any
+}
+
+func (PathField) pathElement()  {}
+func (PathIndex) pathElement()  {}
+func (PathKey) pathElement()    {}
+func (PathMapKey) pathElement() {}
+
+// String returns the string representation of p, such as "user.addresses[2].zip".
+// PathKey is represented as "[key]" and PathMapKey is represented as "{key}".
+func (p Path) String() string {
+	var b strings.Builder
+	for i, elem := range p {
+		switch v := elem.(type) {
+		case PathField:
+			if i > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(string(v))
+		case PathIndex:
+			fmt.Fprintf(&b, "[%d]", int(v))
+		case PathKey:
+			fmt.Fprintf(&b, "[%v]", v.Key)
+		case PathMapKey:
+			fmt.Fprintf(&b, "{%v}", v.Key)
+		}
+	}
+	return b.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It is encoded as the same as String.
+func (p Path) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// PathError records an error and the location where it is caused.
+type PathError struct {
+	Path Path
+	Err  error
+
+	msg    string
+	reason string // the message without the location
+}
+
+// withPath returns the PathError that is located err at elem.
+// The message of the returned error is msg, that contains the location.
+func withPath(elem PathElement, err error, msg string) *PathError {
+	if e, ok := err.(*PathError); ok {
+		return &PathError{
+			Path:   append(Path{elem}, e.Path...),
+			Err:    e.Err,
+			msg:    msg,
+			reason: e.reason,
+		}
+	}
+	return &PathError{
+		Path:   Path{elem},
+		Err:    err,
+		msg:    msg,
+		reason: err.Error(),
+	}
+}
+
+// withMessage returns the error that has the same location as err but its message is msg.
+// Unlike withPath, msg is also used as the message without the location.
+func withMessage(err error, msg string) *PathError {
+	if e, ok := err.(*PathError); ok {
+		return &PathError{
+			Path:   e.Path,
+			Err:    e.Err,
+			msg:    msg,
+			reason: msg,
+		}
+	}
+	return &PathError{
+		Err:    err,
+		msg:    msg,
+		reason: msg,
+	}
+}
+
+// Error implements the error interface.
+func (e *PathError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the underlying error of e.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+var _ Error = (*PathError)(nil)
+
+// Violation represents a violation of a rule.
+type Violation struct {
+	// Path is the location of the value that violates the rule.
+	// It is nil if the violation is caused at top-level value.
+	Path Path `json:"path"`
+
+	// Rule is the name of the violated rule.
+	// It is empty if the error is not a RuleError.
+	Rule string `json:"rule,omitempty"`
+
+	// Message is the localized message that does not contain Path.
+	// If the error is formatted with WithFormat of Join or Pointer, it is the formatted message.
+	Message string `json:"message"`
+
+	// Params is the parameters of the violated rule.
+	// It is nil if the error is not a RuleError.
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// Violations flattens err, that is returned from Validate, into the list of its violations.
+//
+// The errors that are not validation errors, such as *InternalError and the errors of ctx, are not violations.
+// Violations returns nil for them.
+func Violations(err error) []Violation {
+	if err == nil {
+		return nil
+	}
+	var a []Violation
+	for _, err := range flattenErrors(err) {
+		var v Violation
+		v.Message = err.Error()
+		if e, ok := err.(*PathError); ok {
+			v.Path = e.Path
+			v.Message = e.reason
+			err = e.Err
+		}
+		if isAborted(err) {
+			continue
+		}
+		var e *RuleError
+		if errors.As(err, &e) {
+			v.Rule = e.Rule
+			v.Params = e.Params
+		}
+		a = append(a, v)
+	}
+	return a
+}
+
+// isAborted reports whether err itself is the error that aborts the validation.
+func isAborted(err error) bool {
+	if _, ok := err.(*InternalError); ok {
+		return true
+	}
+	return err == context.Canceled || err == context.DeadlineExceeded
+}
+
+// MarshalErrors returns the JSON encoding of the violations in err.
+//
+// The encoding is a flat array of objects that is the same as Violations(err):
+//
+//	[
+//	  {
+//	    "path": "user.addresses[2].zip",
+//	    "rule": "length",
+//	    "message": "the length must be in range(7 ... 7)",
+//	    "params": {"min": 7, "max": 7}
+//	  }
+//	]
+//
+// If err is nil, MarshalErrors returns an empty array.
+func MarshalErrors(err error) ([]byte, error) {
+	a := Violations(err)
+	if a == nil {
+		a = []Violation{}
+	}
+	return json.Marshal(a)
+}
@@ -0,0 +1,196 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/lufia/go-pointer"
+)
+
+func TestPathString(t *testing.T) {
+	tests := map[string]struct {
+		path Path
+		want string
+	}{
+		"empty":  {nil, ""},
+		"field":  {Path{PathField("user")}, "user"},
+		"nested": {Path{PathField("user"), PathField("addresses"), PathIndex(2), PathField("zip")}, "user.addresses[2].zip"},
+		"key":    {Path{PathField("labels"), PathKey{"env"}}, "labels[env]"},
+		"mapKey": {Path{PathField("labels"), PathMapKey{"env"}}, "labels{env}"},
+		"index":  {Path{PathIndex(1), PathField("name")}, "[1].name"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if s := tt.path.String(); s != tt.want {
+				t.Errorf("String() = %q; want %q", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestViolations(t *testing.T) {
+	type (
+		Address struct {
+			Zip string
+		}
+		User struct {
+			Name      *string
+			Addresses []*Address
+			Labels    map[string]string
+		}
+		Request struct {
+			User *User
+		}
+	)
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.User, "user", Struct(func(s StructRule, u *User) {
+			AddField(s, &u.Name, "name", Pointer(Required[string]()))
+			AddField(s, &u.Addresses, "addresses", Slice(Struct(func(s StructRule, a *Address) {
+				AddField(s, &a.Zip, "zip", Length[string](7, 7))
+			})))
+			AddField(s, &u.Labels, "labels", Map[string](nil, Required[string]()))
+		}))
+	})
+	r := Request{
+		User: &User{
+			Name: pointer.New(""),
+			Addresses: []*Address{
+				{Zip: "1234567"},
+				{Zip: "123"},
+			},
+			Labels: map[string]string{"env": ""},
+		},
+	}
+	err := v.Validate(context.Background(), &r)
+	got := Violations(err)
+	want := []Violation{
+		{
+			Path:    Path{PathField("user"), PathField("name")},
+			Message: "cannot be the zero value",
+			Rule:    "required",
+			Params:  map[string]any{},
+		},
+		{
+			Path:    Path{PathField("user"), PathField("addresses"), PathIndex(1), PathField("zip")},
+			Message: "the length must be in range(7 ... 7)",
+			Rule:    "length",
+			Params:  map[string]any{"min": 7, "max": 7},
+		},
+		{
+			Path:    Path{PathField("user"), PathField("labels"), PathKey{"env"}},
+			Message: "cannot be the zero value",
+			Rule:    "required",
+			Params:  map[string]any{},
+		},
+	}
+	sortViolations(got)
+	sortViolations(want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Violations() = %v; want %v", got, want)
+	}
+}
+
+func TestViolations_topLevel(t *testing.T) {
+	err := Min(3).Validate(context.Background(), 1)
+	got := Violations(err)
+	want := []Violation{
+		{Message: "must be no less than 3", Rule: "min", Params: map[string]any{"min": 3}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Violations() = %v; want %v", got, want)
+	}
+	if a := Violations(nil); a != nil {
+		t.Errorf("Violations(nil) = %v; want nil", a)
+	}
+}
+
+func TestViolations_mapKey(t *testing.T) {
+	v := Map(MinLength[string](2), MinLength[string](2))
+	err := v.Validate(context.Background(), map[string]string{"a": "b"})
+	var paths []string
+	for _, v := range Violations(err) {
+		paths = append(paths, v.Path.String())
+	}
+	if want := []string{"{a}", "[a]"}; !slices.Equal(paths, want) {
+		t.Errorf("paths = %q; want %q", paths, want)
+	}
+}
+
+func TestViolations_withFormat(t *testing.T) {
+	type Request struct {
+		Name *string
+	}
+	tests := map[string]struct {
+		v    Validator[*Request]
+		want []string
+	}{
+		"join": {
+			Struct(func(s StructRule, r *Request) {
+				AddField(s, &r.Name, "name", Pointer(Join(Required[string]()).WithFormat("custom message")))
+			}),
+			[]string{"name: custom message"},
+		},
+		"pointer": {
+			Struct(func(s StructRule, r *Request) {
+				AddField(s, &r.Name, "name", Pointer(Required[string]()).WithFormat("is %q", ByName("value")))
+			}),
+			[]string{`name: is ""`},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.v.Validate(context.Background(), &Request{Name: pointer.New("")})
+			var a []string
+			for _, v := range Violations(err) {
+				a = append(a, v.Path.String()+": "+v.Message)
+			}
+			if !slices.Equal(a, tt.want) {
+				t.Errorf("Violations() = %q; want %q", a, tt.want)
+			}
+		})
+	}
+	err := Join(Required[string]()).WithFormat("custom message").Validate(context.Background(), "")
+	if a := Violations(err); len(a) != 1 || a[0].Message != "custom message" || a[0].Rule != "required" {
+		t.Errorf("Violations() = %v; want custom message", a)
+	}
+}
+
+func sortViolations(a []Violation) {
+	slices.SortFunc(a, func(x, y Violation) int {
+		return strings.Compare(x.Path.String(), y.Path.String())
+	})
+}
+
+func TestMarshalErrors(t *testing.T) {
+	type Request struct {
+		Name    string
+		Options []string
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.Name, "name", Length[string](1, 3))
+		AddField(s, &r.Options, "options", Slice(In("a", "b")))
+	})
+	verr := v.Validate(context.Background(), &Request{Options: []string{"a", "c"}})
+	b, err := MarshalErrors(verr)
+	if err != nil {
+		t.Fatalf("MarshalErrors: %v", err)
+	}
+	want := `[` +
+		`{"path":"name","rule":"length","message":"the length must be in range(1 ... 3)","params":{"max":3,"min":1}},` +
+		`{"path":"options[1]","rule":"in","message":"must be a valid value in [a b]","params":{"validValues":["a","b"]}}` +
+		`]`
+	if s := string(b); s != want {
+		t.Errorf("MarshalErrors() = %s; want %s", s, want)
+	}
+
+	b, err = MarshalErrors(nil)
+	if err != nil {
+		t.Fatalf("MarshalErrors(nil): %v", err)
+	}
+	if s := string(b); s != "[]" {
+		t.Errorf("MarshalErrors(nil) = %s; want []", s)
+	}
+}
@@ -2,13 +2,13 @@ package validator
 
 import (
 	"context"
-	"errors"
 	"regexp"
 
 	"golang.org/x/text/message"
 )
 
 // Pattern returns the validator to verify the value matches re.
+// The rule name of its error is "pattern".
 //
 // Two named args are available in its error format.
 //   - pattern: specific regular expression (type *regexp.Regexp)
@@ -44,15 +44,20 @@ func (r *patternValidator[T]) WithFormat(key message.Reference, a ...Arg) Valida
 // Validate validates v.
 func (r *patternValidator[T]) Validate(ctx context.Context, v T) error {
 	if !r.re.MatchString(string(v)) {
-		e := &patternError[T]{
-			Pattern: r.re,
-			Value:   v,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *patternValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &patternError[T]{
+		Pattern: r.re,
+		Value:   v,
+	}
+	return newRuleError(ctx, "pattern", e, r.format)
+}
+
 // patternError reports an error is caused in Pattern validator.
 type patternError[T ~string] struct {
 	Pattern *regexp.Regexp `arg:"pattern"`
@@ -7,22 +7,37 @@ import (
 )
 
 // Pointer returns the validator to verify a pointer to something.
+// A nil pointer is always valid. To report a nil pointer as an error, use RequiredPointer.
+//
+// By default, the errors of vs are reported as is.
+// Once WithFormat is called, each error is formatted with its format.
+// Two named args are available in its error format.
+//   - value: the value that the pointer points to (type T)
+//   - error: occurred validation error (type error)
 func Pointer[T any](vs ...Validator[T]) Validator[*T] {
-	return &pointerValidator[*T, T]{
+	return PointerOf[*T](vs...)
+}
+
+// PointerOf is like Pointer but returns the validator for the named pointer type P.
+//
+//	type Name *string
+//	v := validator.PointerOf[Name](validator.Required[string]())
+func PointerOf[P ~*T, T any](vs ...Validator[T]) Validator[P] {
+	return &pointerValidator[P, T]{
 		vs: vs,
 	}
 }
 
 // pointerValidator represents the validator to check pointer value.
 type pointerValidator[P ~*T, T any] struct {
-	vs []Validator[T]
+	vs     []Validator[T]
+	format *errorFormat
 }
 
 // WithFormat returns shallow copy of r with its error format changed to key.
-//
-// TODO(lufia): currently key is always ignored.
 func (r *pointerValidator[P, T]) WithFormat(key message.Reference, a ...Arg) Validator[P] {
 	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
 	return &rr
 }
 
@@ -31,10 +46,23 @@ func (r *pointerValidator[P, T]) Validate(ctx context.Context, p P) error {
 	if p == nil {
 		return nil
 	}
+	v := *p
 	var errs []error
 	for _, rule := range r.vs {
-		if err := rule.Validate(ctx, *p); err != nil {
-			errs = append(errs, err)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := rule.Validate(ctx, v)
+		if err := abortError(ctx, err); err != nil {
+			return err
+		}
+		if err != nil {
+			errs = append(errs, formatErrors(ctx, err, r.format, func(err error) any {
+				return &pointerError[T]{
+					Value: v,
+					Err:   err,
+				}
+			}))
 		}
 	}
 	if len(errs) > 0 {
@@ -42,3 +70,60 @@ func (r *pointerValidator[P, T]) Validate(ctx context.Context, p P) error {
 	}
 	return nil
 }
+
+// pointerError reports an error is caused in Pointer validator.
+type pointerError[T any] struct {
+	Value T     `arg:"value"`
+	Err   error `arg:"error"`
+}
+
+// RequiredPointer returns the validator to verify a pointer is not nil and its target satisfies vs.
+// The rule name of its error for a nil pointer is "nonNil".
+//
+// A named arg is available in its error format, that is used for a nil pointer.
+//   - value: user input (type *T)
+func RequiredPointer[T any](vs ...Validator[T]) Validator[*T] {
+	return &requiredPointerValidator[*T, T]{
+		v:      Pointer(vs...),
+		format: nonNilErrorFormat,
+	}
+}
+
+// requiredPointerValidator represents the validator to check the pointer is not nil.
+type requiredPointerValidator[P ~*T, T any] struct {
+	v      Validator[P]
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *requiredPointerValidator[P, T]) WithFormat(key message.Reference, a ...Arg) Validator[P] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates p.
+func (r *requiredPointerValidator[P, T]) Validate(ctx context.Context, p P) error {
+	if p == nil {
+		return r.ruleError(ctx, p)
+	}
+	return r.v.Validate(ctx, p)
+}
+
+// ruleError returns the error that reports p does not satisfy r.
+func (r *requiredPointerValidator[P, T]) ruleError(ctx context.Context, p P) *RuleError {
+	e := &nonNilError[P]{
+		Value: p,
+	}
+	return newRuleError(ctx, "nonNil", e, r.format)
+}
+
+// nonNilError reports an error is caused in RequiredPointer validator or WithNilPolicy.
+type nonNilError[P any] struct {
+	Value P `arg:"value"`
+}
+
+var (
+	_ Validator[*string] = (*pointerValidator[*string, string])(nil)
+	_ Validator[*string] = (*requiredPointerValidator[*string, string])(nil)
+)
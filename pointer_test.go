@@ -14,3 +14,43 @@ func TestPointer(t *testing.T) {
 		testValidate(t, v, nil, "")
 	})
 }
+
+func TestRequiredPointer(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := RequiredPointer(Required[string]())
+		testValidate(t, v, pointer.New("test"), "")
+		testValidate(t, v, pointer.New(""), "cannot be the zero value")
+		testValidate(t, v, nil, "must not be nil")
+	})
+	t.Run("none", func(t *testing.T) {
+		v := RequiredPointer[int]()
+		testValidate(t, v, pointer.New(0), "")
+		testValidate(t, v, nil, "must not be nil")
+	})
+}
+
+func TestRequiredPointerWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := RequiredPointer(Required[string]()).WithFormat("is missing")
+		testValidate(t, v, nil, "is missing")
+		testValidate(t, v, pointer.New(""), "cannot be the zero value")
+	})
+}
+
+func TestPointerOf(t *testing.T) {
+	type Name *string
+	t.Run("named", func(t *testing.T) {
+		v := PointerOf[Name](Required[string]())
+		testValidate(t, v, Name(pointer.New("test")), "")
+		testValidate(t, v, Name(pointer.New("")), "cannot be the zero value")
+		testValidate(t, v, nil, "")
+	})
+}
+
+func TestPointerWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Pointer(MinLength[string](3)).WithFormat("%q: %v", ByName("value"), ByName("error"))
+		testValidate(t, v, pointer.New("ab"), `"ab": the length must be no less than 3`)
+		testValidate(t, v, nil, "")
+	})
+}
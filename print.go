@@ -20,15 +20,18 @@ func WithPrinter(ctx context.Context, p Printer) context.Context {
 	return context.WithValue(ctx, printerKey{}, p)
 }
 
-func ctxPrint(ctx context.Context, v any, key message.Reference, args []Arg) string {
-	var (
-		w bytes.Buffer
-		p Printer = defaultPrinter
-	)
-	if pp := ctx.Value(printerKey{}); pp != nil {
-		p = pp.(Printer)
+// PrinterFrom returns the Printer that is set to ctx with WithPrinter.
+// If ctx does not have any Printer, PrinterFrom returns the default Printer.
+func PrinterFrom(ctx context.Context) Printer {
+	if p := ctx.Value(printerKey{}); p != nil {
+		return p.(Printer)
 	}
+	return defaultPrinter
+}
 
+func ctxPrint(ctx context.Context, v any, key message.Reference, args []Arg) string {
+	var w bytes.Buffer
+	p := PrinterFrom(ctx)
 	a := make([]any, len(args))
 	for i, arg := range args {
 		a[i] = arg.ValueOf(v)
@@ -63,3 +66,20 @@ func (a *namedArg) ValueOf(v any) any {
 	}
 	return nil
 }
+
+// argsOf returns all the named args of v.
+func argsOf(v any) map[string]any {
+	p := reflect.ValueOf(v)
+	if p.Kind() == reflect.Pointer {
+		p = p.Elem()
+	}
+	args := make(map[string]any)
+	for _, f := range reflect.VisibleFields(p.Type()) {
+		name := f.Tag.Get("arg")
+		if name == "" {
+			continue
+		}
+		args[name] = p.FieldByIndex(f.Index).Interface()
+	}
+	return args
+}
@@ -0,0 +1,90 @@
+// Package problem converts validation errors into problem details defined in RFC 9457.
+//
+// The returned Details has "invalid-params" extension member
+// that lists each invalid field and its localized reason.
+//
+//	err := requestValidator.Validate(ctx, &r)
+//	if err != nil {
+//		problem.New(ctx, err).ServeHTTP(w, req)
+//		return
+//	}
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/lufia/go-validator"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// ContentType is the media type of the problem details in JSON format.
+const ContentType = "application/problem+json"
+
+const titleKey = "Your request parameters didn't validate."
+
+// titles is the catalog of the title of Details.
+var titles = catalog.NewBuilder(catalog.Fallback(language.English))
+
+func init() {
+	titles.SetString(language.English, titleKey, "Your request parameters didn't validate.")
+	titles.SetString(language.Japanese, titleKey, "リクエストパラメータが不正です。")
+}
+
+// Details represents a problem details document.
+type Details struct {
+	Type     string `json:"type,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Status   int    `json:"status,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	// InvalidParams is the extension member that lists each violation.
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam represents a violation in the "invalid-params" member.
+type InvalidParam struct {
+	// Name is the path to the invalid value, such as "user.addresses[2].zip".
+	Name string `json:"name"`
+
+	// Reason is the localized message of the violation.
+	Reason string `json:"reason"`
+}
+
+// New returns the problem details that describes err returned from Validate.
+// Its title is localized in the language of ctx that is returned from validator.LanguageFrom,
+// and its reasons are localized with the Printer in ctx.
+//
+// The status of returned Details is http.StatusBadRequest.
+// If err is not a validation error, such as *validator.InternalError, its InvalidParams is empty.
+func New(ctx context.Context, err error) *Details {
+	d := &Details{
+		Title:  title(ctx),
+		Status: http.StatusBadRequest,
+	}
+	for _, v := range validator.Violations(err) {
+		d.InvalidParams = append(d.InvalidParams, InvalidParam{
+			Name:   v.Path.String(),
+			Reason: v.Message,
+		})
+	}
+	return d
+}
+
+func title(ctx context.Context) string {
+	p := message.NewPrinter(validator.LanguageFrom(ctx), message.Catalog(titles))
+	return p.Sprintf(titleKey)
+}
+
+// ServeHTTP writes d to w as the response.
+func (d *Details) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(d.Status)
+	json.NewEncoder(w).Encode(d)
+}
+
+var _ http.Handler = (*Details)(nil)
@@ -0,0 +1,79 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/lufia/go-validator"
+	"golang.org/x/text/language"
+)
+
+type request struct {
+	Name    string
+	Options []string
+}
+
+var requestValidator = validator.Struct(func(s validator.StructRule, r *request) {
+	validator.AddField(s, &r.Name, "name", validator.Required[string]())
+	validator.AddField(s, &r.Options, "options", validator.Slice(validator.In("a", "b")))
+})
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	err := requestValidator.Validate(ctx, &request{Options: []string{"c"}})
+	d := New(ctx, err)
+	want := &Details{
+		Title:  "Your request parameters didn't validate.",
+		Status: http.StatusBadRequest,
+		InvalidParams: []InvalidParam{
+			{Name: "name", Reason: "cannot be the zero value"},
+			{Name: "options[0]", Reason: "must be a valid value in [a b]"},
+		},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("New() = %+v; want %+v", d, want)
+	}
+}
+
+func TestNew_localized(t *testing.T) {
+	ctx := validator.WithLanguage(context.Background(), language.Japanese)
+	err := requestValidator.Validate(ctx, &request{})
+	d := New(ctx, err)
+	want := &Details{
+		Title:  "リクエストパラメータが不正です。",
+		Status: http.StatusBadRequest,
+		InvalidParams: []InvalidParam{
+			{Name: "name", Reason: "必須です"},
+		},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("New() = %+v; want %+v", d, want)
+	}
+}
+
+func TestDetailsServeHTTP(t *testing.T) {
+	ctx := context.Background()
+	err := requestValidator.Validate(ctx, &request{})
+	w := httptest.NewRecorder()
+	New(ctx, err).ServeHTTP(w, httptest.NewRequest("POST", "/", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d; want %d", w.Code, http.StatusBadRequest)
+	}
+	if s := w.Header().Get("Content-Type"); s != ContentType {
+		t.Errorf("Content-Type = %q; want %q", s, ContentType)
+	}
+	var m map[string]any
+	if err := json.NewDecoder(w.Body).Decode(&m); err != nil {
+		t.Fatal(err)
+	}
+	want := []any{
+		map[string]any{"name": "name", "reason": "cannot be the zero value"},
+	}
+	if a := m["invalid-params"]; !reflect.DeepEqual(a, want) {
+		t.Errorf("invalid-params = %v; want %v", a, want)
+	}
+}
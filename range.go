@@ -3,7 +3,6 @@ package validator
 import (
 	"cmp"
 	"context"
-	"errors"
 
 	"golang.org/x/text/message"
 )
@@ -13,6 +12,7 @@ type ordered interface {
 }
 
 // Min returns the validator to verify the value is greater or equal than n.
+// The rule name of its error is "min".
 //
 // Two named args are available in its error format.
 //   - min: specified min value (type T)
@@ -40,15 +40,20 @@ func (r *minValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[
 // Validate validates v.
 func (r *minValidator[T]) Validate(ctx context.Context, v T) error {
 	if v < r.min {
-		e := &minError[T]{
-			Min:   r.min,
-			Value: v,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *minValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &minError[T]{
+		Min:   r.min,
+		Value: v,
+	}
+	return newRuleError(ctx, "min", e, r.format)
+}
+
 // minError reports an error is caused in Min validator.
 type minError[T ordered] struct {
 	Min   T `arg:"min"`
@@ -58,6 +63,7 @@ type minError[T ordered] struct {
 var _ Validator[int] = (*minValidator[int])(nil)
 
 // Max returns the validator to verify the value is less or equal than n.
+// The rule name of its error is "max".
 //
 // Two named args are available in its error format.
 //   - max: specified max value (type T)
@@ -85,15 +91,20 @@ func (r *maxValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[
 // Validate validates v.
 func (r *maxValidator[T]) Validate(ctx context.Context, v T) error {
 	if v > r.max {
-		e := &maxError[T]{
-			Value: v,
-			Max:   r.max,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *maxValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &maxError[T]{
+		Value: v,
+		Max:   r.max,
+	}
+	return newRuleError(ctx, "max", e, r.format)
+}
+
 // maxError reports an error is caused in Max validator.
 type maxError[T ordered] struct {
 	Max   T `arg:"max"`
@@ -103,6 +114,7 @@ type maxError[T ordered] struct {
 var _ Validator[int] = (*maxValidator[int])(nil)
 
 // InRange returns the validator to verify the value is within min and max.
+// The rule name of its error is "inRange".
 //
 // Three named args are available in its error format.
 //   - min: specified min value (type T)
@@ -133,16 +145,21 @@ func (r *inRangeValidator[T]) WithFormat(key message.Reference, a ...Arg) Valida
 // Validate validates v.
 func (r *inRangeValidator[T]) Validate(ctx context.Context, v T) error {
 	if v < r.min || v > r.max {
-		e := &inRangeError[T]{
-			Min:   r.min,
-			Max:   r.max,
-			Value: v,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *inRangeValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &inRangeError[T]{
+		Min:   r.min,
+		Max:   r.max,
+		Value: v,
+	}
+	return newRuleError(ctx, "inRange", e, r.format)
+}
+
 // inRangeError reports an error is caused in InRange validator.
 type inRangeError[T ordered] struct {
 	Min   T `arg:"min"`
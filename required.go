@@ -2,12 +2,13 @@ package validator
 
 import (
 	"context"
-	"errors"
+	"reflect"
 
 	"golang.org/x/text/message"
 )
 
 // Required returns the validator to verify the value is not zero value.
+// The rule name of its error is "required".
 //
 // A named arg is available in its error format.
 //   - value: user input (type T)
@@ -33,17 +34,142 @@ func (r *requiredValidator[T]) WithFormat(key message.Reference, a ...Arg) Valid
 func (r *requiredValidator[T]) Validate(ctx context.Context, v T) error {
 	var v0 T
 	if v == v0 {
-		e := &requiredError[T]{
-			Value: v,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *requiredValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &requiredError[T]{
+		Value: v,
+	}
+	return newRuleError(ctx, "required", e, r.format)
+}
+
 // requiredError reports an error is caused in Required validator.
 type requiredError[T comparable] struct {
 	Value T `arg:"value"`
 }
 
 var _ Validator[string] = (*requiredValidator[string])(nil)
+
+// RequiredSlice returns the validator to verify the slice is neither nil nor empty.
+// To allow an empty slice, use NonNil instead.
+// The rule name of its error is "required".
+//
+// A named arg is available in its error format.
+//   - value: user input (type S)
+func RequiredSlice[S ~[]T, T any]() Validator[S] {
+	return &emptyValidator[S]{
+		empty:  func(v S) bool { return len(v) == 0 },
+		rule:   "required",
+		format: requiredErrorFormat,
+	}
+}
+
+// RequiredMap returns the validator to verify the map is neither nil nor empty.
+// To allow an empty map, use NonNil instead.
+// The rule name of its error is "required".
+//
+// A named arg is available in its error format.
+//   - value: user input (type M)
+func RequiredMap[M ~map[K]V, K comparable, V any]() Validator[M] {
+	return &emptyValidator[M]{
+		empty:  func(v M) bool { return len(v) == 0 },
+		rule:   "required",
+		format: requiredErrorFormat,
+	}
+}
+
+// RequiredInterface returns the validator to verify the interface value is not nil.
+// In addition, the interface value holding a nil pointer, a nil slice or a nil map etc. is also invalid.
+// T must be an interface type, such as error; otherwise RequiredInterface panics.
+// To verify the other types are not nil, use NonNil instead.
+// The rule name of its error is "required".
+//
+// A named arg is available in its error format.
+//   - value: user input (type T)
+func RequiredInterface[T any]() Validator[T] {
+	if t := reflect.TypeFor[T](); t.Kind() != reflect.Interface {
+		panic("validator: " + t.String() + " is not an interface")
+	}
+	return &emptyValidator[T]{
+		empty:  isNil,
+		rule:   "required",
+		format: requiredErrorFormat,
+	}
+}
+
+// NonNil returns the validator to verify the value is not nil.
+// Unlike RequiredSlice or RequiredMap, the empty slice or the empty map is valid.
+// The rule name of its error is "nonNil", the same as RequiredPointer.
+//
+// A named arg is available in its error format.
+//   - value: user input (type T)
+func NonNil[T any]() Validator[T] {
+	return &emptyValidator[T]{
+		empty:  isNil,
+		rule:   "nonNil",
+		format: nonNilErrorFormat,
+	}
+}
+
+func isNil[T any](v T) bool {
+	p := reflect.ValueOf(any(v))
+	switch p.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice, reflect.UnsafePointer:
+		return p.IsNil()
+	}
+	return false
+}
+
+// emptyValidator represents the validator to check the value is not empty.
+type emptyValidator[T any] struct {
+	empty  func(v T) bool
+	rule   string
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *emptyValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *emptyValidator[T]) Validate(ctx context.Context, v T) error {
+	if r.empty(v) {
+		return r.ruleError(ctx, v)
+	}
+	return nil
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *emptyValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &emptyError[T]{
+		Value: v,
+	}
+	return newRuleError(ctx, r.rule, e, r.format)
+}
+
+// emptyError reports an error is caused in RequiredSlice, RequiredMap, RequiredInterface and NonNil validator.
+type emptyError[T any] struct {
+	Value T `arg:"value"`
+}
+
+var _ Validator[[]string] = (*emptyValidator[[]string])(nil)
+
+// Optional returns the validator to verify the value with vs only if the value is not zero value.
+// The zero value is always valid.
+//
+// The validator reports the errors the same as Join(vs...).
+func Optional[T comparable](vs ...Validator[T]) Validator[T] {
+	return When(func(ctx context.Context, v T) bool {
+		var v0 T
+		return v != v0
+	}, vs...)
+}
@@ -1,6 +1,9 @@
 package validator
 
 import (
+	"context"
+	"errors"
+	"io"
 	"testing"
 )
 
@@ -24,3 +27,120 @@ func TestRequiredWithFormat(t *testing.T) {
 		testValidate(t, v, "", "is empty")
 	})
 }
+
+func TestOptional(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Optional(MinLength[string](3), PatternString[string]("^[a-z]+$"))
+		testValidate(t, v, "", "")
+		testValidate(t, v, "abc", "")
+		testValidate(t, v, "A", "the length must be no less than 3\nmust match the pattern /^[a-z]+$/")
+	})
+	t.Run("int", func(t *testing.T) {
+		v := Optional(Min(10))
+		testValidate(t, v, 0, "")
+		testValidate(t, v, 1, "must be no less than 10")
+	})
+}
+
+func TestRequiredSlice(t *testing.T) {
+	type Tags []string
+	t.Run("string", func(t *testing.T) {
+		v := RequiredSlice[[]string]()
+		testValidate(t, v, []string{"a"}, "")
+		testValidate(t, v, []string{}, "cannot be the zero value")
+		testValidate(t, v, nil, "cannot be the zero value")
+	})
+	t.Run("named", func(t *testing.T) {
+		v := RequiredSlice[Tags]()
+		testValidate(t, v, Tags{"a"}, "")
+		testValidate(t, v, Tags{}, "cannot be the zero value")
+	})
+}
+
+func TestRequiredMap(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := RequiredMap[map[string]int]()
+		testValidate(t, v, map[string]int{"a": 0}, "")
+		testValidate(t, v, map[string]int{}, "cannot be the zero value")
+		testValidate(t, v, nil, "cannot be the zero value")
+	})
+}
+
+func TestRequiredInterface(t *testing.T) {
+	t.Run("any", func(t *testing.T) {
+		v := RequiredInterface[any]()
+		testValidate[Validator[any], any](t, v, 0, "")
+		testValidate[Validator[any], any](t, v, "", "")
+		testValidate[Validator[any], any](t, v, nil, "cannot be the zero value")
+		testValidate[Validator[any], any](t, v, (*int)(nil), "cannot be the zero value")
+	})
+	t.Run("error", func(t *testing.T) {
+		v := RequiredInterface[error]()
+		testValidate(t, v, io.EOF, "")
+		testValidate(t, v, nil, "cannot be the zero value")
+	})
+}
+
+func TestRequiredInterface_field(t *testing.T) {
+	type Request struct {
+		Meta any
+		Err  error
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.Meta, "meta", RequiredInterface[any]())
+		AddField(s, &r.Err, "err", NonNil[error]())
+	})
+	testValidate(t, v, &Request{Meta: 1, Err: io.EOF}, "")
+	testValidate(t, v, &Request{}, "meta: cannot be the zero value\nerr: must not be nil")
+}
+
+func TestRequiredInterface_notInterface(t *testing.T) {
+	defer func() {
+		if e := recover(); e == nil {
+			t.Errorf("RequiredInterface should panic")
+		}
+	}()
+	RequiredInterface[int]()
+}
+
+func TestNonNil(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		v := NonNil[[]string]()
+		testValidate(t, v, []string{}, "")
+		testValidate(t, v, nil, "must not be nil")
+	})
+	t.Run("map", func(t *testing.T) {
+		v := NonNil[map[string]int]()
+		testValidate(t, v, map[string]int{}, "")
+		testValidate(t, v, nil, "must not be nil")
+	})
+	t.Run("int", func(t *testing.T) {
+		v := NonNil[int]()
+		testValidate(t, v, 0, "")
+	})
+}
+
+func TestNonNil_rule(t *testing.T) {
+	type User struct{}
+	tests := map[string]Validator[*User]{
+		"NonNil":          NonNil[*User](),
+		"RequiredPointer": RequiredPointer[User](),
+		"WithNilPolicy":   WithNilPolicy(Struct(func(s StructRule, u *User) {}), NilAsInvalid),
+	}
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := v.Validate(context.Background(), nil)
+			var e *RuleError
+			if !errors.As(err, &e) || e.Rule != "nonNil" || e.Error() != "must not be nil" {
+				t.Errorf("Validate(nil) = %v; want nonNil rule", err)
+			}
+		})
+	}
+}
+
+func TestRequiredSliceWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := RequiredSlice[[]string]().WithFormat("is empty")
+		testValidate(t, v, nil, "is empty")
+	})
+}
@@ -7,37 +7,65 @@ import (
 )
 
 // Slice returns the validator to verify a slice.
+//
+// Each error of the elements is formatted with its error format that contains the index by default.
+// Three named args are available in its error format.
+//   - index: the index of the element (type int)
+//   - value: the element (type T)
+//   - error: occurred validation error (type error)
 func Slice[T any](vs ...Validator[T]) Validator[[]T] {
-	return &sliceValidator[[]T, T]{
-		vs: vs,
+	return SliceOf[[]T](vs...)
+}
+
+// SliceOf is like Slice but returns the validator for the named slice type S.
+//
+//	type Tags []string
+//	v := validator.SliceOf[Tags](validator.Required[string]())
+func SliceOf[S ~[]T, T any](vs ...Validator[T]) Validator[S] {
+	return &sliceValidator[S, T]{
+		vs:     vs,
+		format: sliceElemErrorFormat,
 	}
 }
 
 // sliceValidator represents the validator to check slice elements.
 type sliceValidator[S ~[]T, T any] struct {
-	vs []Validator[T]
+	vs      []Validator[T]
+	format  *errorFormat
+	workers int
 }
 
 // WithFormat returns shallow copy of r with its error format changed to key.
-//
-// TODO(lufia): currently key is always ignored.
 func (r *sliceValidator[S, T]) WithFormat(key message.Reference, a ...Arg) Validator[S] {
 	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// parallel returns shallow copy of r that validates with n goroutines.
+func (r *sliceValidator[S, T]) parallel(n int) Validator[S] {
+	rr := *r
+	rr.workers = n
 	return &rr
 }
 
 // Validate validates v.
 func (r *sliceValidator[S, T]) Validate(ctx context.Context, v S) error {
+	errs := make([]error, len(v))
+	forEach(ctx, len(v), r.workers, func(i int) bool {
+		errs[i] = validateElem(ctx, i, v[i], r.vs, r.format)
+		return abortError(ctx, errs[i]) == nil
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	var m OrderedMap[int, error]
-	for i, elem := range v {
-		var errs []error
-		for _, rule := range r.vs {
-			if err := rule.Validate(ctx, elem); err != nil {
-				errs = append(errs, err)
-			}
+	for i, err := range errs {
+		if err := abortError(ctx, err); err != nil {
+			return err
 		}
-		if len(errs) > 0 {
-			m.set(i, joinErrors(errs...))
+		if err != nil {
+			m.set(i, err)
 		}
 	}
 	if m.Len() > 0 {
@@ -49,6 +77,44 @@ func (r *sliceValidator[S, T]) Validate(ctx context.Context, v S) error {
 	return nil
 }
 
+// validateElem validates elem at i with vs.
+// The errors are formatted with format.
+// If the validation is aborted, validateElem returns the error of abortError.
+func validateElem[T any](ctx context.Context, i int, elem T, vs []Validator[T], format *errorFormat) error {
+	var errs []error
+	for _, rule := range vs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := rule.Validate(ctx, elem)
+		if err := abortError(ctx, err); err != nil {
+			return err
+		}
+		if err != nil {
+			err = wrapErrors(err, func(err error) error {
+				e := &sliceElemError[T]{
+					Index: i,
+					Value: elem,
+					Err:   err,
+				}
+				return withPath(PathIndex(i), err, ctxPrint(ctx, e, format.Key, format.Args))
+			})
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return joinErrors(errs...)
+	}
+	return nil
+}
+
+// sliceElemError reports an error is caused in the element of Slice or Array validator.
+type sliceElemError[T any] struct {
+	Index int   `arg:"index"`
+	Value T     `arg:"value"`
+	Err   error `arg:"error"`
+}
+
 // SliceError reports an error is caused in Slice validator.
 type SliceError[S ~[]T, T any] struct {
 	Value  S
@@ -75,6 +141,7 @@ func (e SliceError[S, T]) Unwrap() []error {
 }
 
 var (
-	_ Validator[[]any] = (*sliceValidator[[]any, any])(nil)
-	_ Error            = (*SliceError[[]any, any])(nil)
+	_ Validator[[]any]    = (*sliceValidator[[]any, any])(nil)
+	_ parallelizer[[]any] = (*sliceValidator[[]any, any])(nil)
+	_ Error               = (*SliceError[[]any, any])(nil)
 )
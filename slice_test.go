@@ -1,7 +1,10 @@
 package validator
 
 import (
+	"context"
 	"testing"
+
+	"golang.org/x/text/language"
 )
 
 func TestSlice(t *testing.T) {
@@ -9,10 +12,53 @@ func TestSlice(t *testing.T) {
 		v := Slice(Required[string]())
 		testValidate(t, v, []string{"a", "ab"}, "")
 		testValidate(t, v, []string(nil), "")
-		testValidate(t, v, []string{""}, "cannot be the zero value")
+		testValidate(t, v, []string{""}, "index 0: cannot be the zero value")
 	})
 	t.Run("int", func(t *testing.T) {
 		v := Slice(Required[int]())
 		testValidate(t, v, []int{1, -1}, "")
 	})
 }
+
+func TestSliceOf(t *testing.T) {
+	type Tags []string
+	t.Run("named", func(t *testing.T) {
+		v := SliceOf[Tags](Required[string]())
+		testValidate(t, v, Tags{"a", "ab"}, "")
+		testValidate(t, v, Tags{""}, "index 0: cannot be the zero value")
+	})
+	t.Run("field", func(t *testing.T) {
+		type Request struct {
+			Tags Tags
+		}
+		v := Struct(func(s StructRule, r *Request) {
+			AddField(s, &r.Tags, "tags", SliceOf[Tags](Required[string]()), MaxItems[Tags](1))
+		})
+		testValidate(t, v, &Request{Tags: Tags{"a"}}, "")
+		testValidate(t, v, &Request{Tags: Tags{"a", ""}}, "tags: index 1: cannot be the zero value\ntags: the number of items must be no greater than 1")
+	})
+}
+
+func TestSliceWithFormat(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Slice(In("a", "b")).WithFormat("options[%d]: %v (%q)", ByName("index"), ByName("error"), ByName("value"))
+		testValidate(t, v, []string{"a", "c"}, `options[1]: must be a valid value in [a b] ("c")`)
+	})
+	t.Run("path", func(t *testing.T) {
+		v := Slice(Required[string]()).WithFormat("#%d", ByName("index"))
+		err := v.Validate(context.Background(), []string{"a", ""})
+		a := Violations(err)
+		if len(a) != 1 || a[0].Path.String() != "[1]" || a[0].Message != "cannot be the zero value" {
+			t.Errorf("Violations() = %v", a)
+		}
+	})
+}
+
+func TestSlice_localized(t *testing.T) {
+	v := Slice(Required[string]())
+	ctx := WithLanguage(context.Background(), language.Japanese)
+	err := v.Validate(ctx, []string{"a", ""})
+	if want := "インデックス1: 必須です"; err == nil || err.Error() != want {
+		t.Errorf("Validate() = %v; want %s", err, want)
+	}
+}
@@ -2,7 +2,6 @@ package validator
 
 import (
 	"context"
-	"errors"
 	"reflect"
 
 	"golang.org/x/text/message"
@@ -10,6 +9,9 @@ import (
 
 // Struct returns the validator to verify that the struct satisfies rules constrated with build.
 //
+// When the struct pointer is nil, the validator validates the zero values of its fields.
+// To change the behavior, use WithNilPolicy.
+//
 // Three named args are available in its error format.
 //   - name: the registered field name (type string)
 //   - value: user input
@@ -20,8 +22,7 @@ func Struct[P ~*T, T any](build func(s StructRule, p P)) Validator[P] {
 		v T
 	)
 	rule := structRule[P, T]{
-		base:   &v,
-		fields: make(map[string]structFieldRef),
+		base: &v,
 	}
 	build(&rule, &v)
 	s.rule = &rule
@@ -29,10 +30,74 @@ func Struct[P ~*T, T any](build func(s StructRule, p P)) Validator[P] {
 	return &s
 }
 
+// NilPolicy specifies how the validator treats a nil pointer.
+type NilPolicy int
+
+const (
+	// NilAsZero validates a nil pointer as if it points to the zero value.
+	// It is the default behavior of Struct.
+	NilAsZero NilPolicy = iota
+
+	// NilAsValid treats a nil pointer as valid; it is useful for optional sub-objects.
+	NilAsValid
+
+	// NilAsInvalid reports a nil pointer as a single error the same as RequiredPointer.
+	//
+	// Its rule name is "nonNil" instead of "required" of Required,
+	// so that every check for a nil value, such as RequiredPointer and NonNil, reports the same rule and message.
+	NilAsInvalid
+)
+
+// WithNilPolicy returns the validator that treats a nil pointer with policy, then validates a non-nil pointer with v.
+//
+// When the policy is NilAsInvalid, the rule name of its error is "nonNil" (see NilAsInvalid),
+// and a named arg is available in its error format.
+//   - value: user input (type P)
+func WithNilPolicy[P ~*T, T any](v Validator[P], policy NilPolicy) Validator[P] {
+	return &nilPolicyValidator[P, T]{
+		v:      v,
+		policy: policy,
+		format: nonNilErrorFormat,
+	}
+}
+
+// nilPolicyValidator represents the validator to treat a nil pointer with its policy.
+type nilPolicyValidator[P ~*T, T any] struct {
+	v      Validator[P]
+	policy NilPolicy
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format, for nil pointers, changed to key.
+func (r *nilPolicyValidator[P, T]) WithFormat(key message.Reference, a ...Arg) Validator[P] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v.
+func (r *nilPolicyValidator[P, T]) Validate(ctx context.Context, v P) error {
+	if v == nil {
+		switch r.policy {
+		case NilAsValid:
+			return nil
+		case NilAsInvalid:
+			e := &nonNilError[P]{
+				Value: v,
+			}
+			return newRuleError(ctx, "nonNil", e, r.format)
+		}
+	}
+	return r.v.Validate(ctx, v)
+}
+
+var _ Validator[*int] = (*nilPolicyValidator[*int, int])(nil)
+
 // structValidator represents the validator to check the struct satisfies its rules.
 type structValidator[P ~*T, T any] struct {
-	rule   *structRule[P, T]
-	format *errorFormat
+	rule    *structRule[P, T]
+	format  *errorFormat
+	workers int
 }
 
 // WithFormat returns shallow copy of r with its error format changed to key.
@@ -42,27 +107,95 @@ func (r *structValidator[P, T]) WithFormat(key message.Reference, a ...Arg) Vali
 	return &rr
 }
 
+// parallel returns shallow copy of r that validates with n goroutines.
+func (r *structValidator[P, T]) parallel(n int) Validator[P] {
+	rr := *r
+	rr.workers = n
+	return &rr
+}
+
 // Validate validates v.
 func (r *structValidator[P, T]) Validate(ctx context.Context, v P) error {
-	errs := make(map[string]error)
-	for name, rule := range r.rule.fields {
-		if err := rule.validateField(ctx, v, r.format.Key, r.format.Args); err != nil {
-			errs[name] = err
+	ctx = context.WithValue(ctx, structBaseKey{}, v)
+	names := r.rule.fields.Keys()
+	fieldErrs := make([]error, len(names))
+	forEach(ctx, len(names), r.workers, func(i int) bool {
+		rule, _ := r.rule.fields.Get(names[i])
+		fieldErrs[i] = rule.validateField(ctx, v, r.format.Key, r.format.Args)
+		return fieldErrs[i] == nil || !r.rule.failFast && abortError(ctx, fieldErrs[i]) == nil
+	})
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	errs := make(map[string][]error)
+	for i, err := range fieldErrs {
+		if err := abortError(ctx, err); err != nil {
+			return err
+		}
+		if err != nil {
+			errs[names[i]] = append(errs[names[i]], err)
+			if r.rule.failFast {
+				// Under Parallel, the fields after it might be validated; their results are ignored.
+				break
+			}
 		}
 	}
-	if len(errs) > 0 {
+	p := v
+	if p == nil {
+		p = new(T)
+	}
+	for _, fn := range r.rule.rules {
+		if r.rule.failFast && len(errs) > 0 {
+			break
+		}
+		var aborted error
+		fn(ctx, p, func(name string, err error) {
+			if err == nil || aborted != nil {
+				return
+			}
+			rule, ok := r.rule.fields.Get(name)
+			if !ok {
+				panic("validator: the field " + name + " is not added")
+			}
+			if err := abortError(ctx, err); err != nil {
+				aborted = err
+				return
+			}
+			err = rule.reportError(ctx, v, err, r.format.Key, r.format.Args)
+			errs[name] = append(errs[name], err)
+		})
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if aborted != nil {
+			return aborted
+		}
+	}
+
+	var m OrderedMap[string, error]
+	for _, name := range r.rule.fields.Keys() {
+		if a := errs[name]; len(a) > 0 {
+			m.set(name, joinErrors(a...))
+			if r.rule.failFast {
+				break
+			}
+		}
+	}
+	if m.Len() > 0 {
 		return &StructError[P, T]{
 			Value:  v,
-			Errors: errs,
+			Errors: &m,
 		}
 	}
 	return nil
 }
 
 // StructError reports an error is caused in Struct validator.
+//
+// Errors preserves the order in which the fields were added with AddField.
 type StructError[P ~*T, T any] struct {
 	Value  P
-	Errors map[string]error
+	Errors *OrderedMap[string, error]
 }
 
 // Error implements the error interface.
@@ -72,33 +205,60 @@ func (e StructError[P, T]) Error() string {
 
 // Unwrap returns each errors of err.
 func (e StructError[P, T]) Unwrap() []error {
-	if len(e.Errors) == 0 {
+	n := e.Errors.Len()
+	if n == 0 {
 		return nil
 	}
-	errs := make([]error, 0, len(e.Errors))
-	for _, err := range e.Errors {
+	errs := make([]error, 0, n)
+	for _, name := range e.Errors.Keys() {
+		err, _ := e.Errors.Get(name)
 		errs = append(errs, err)
 	}
 	return errs
 }
 
 var (
-	_ Validator[*int] = (*structValidator[*int, int])(nil)
-	_ Error           = (*StructError[*int, int])(nil)
+	_ Validator[*int]    = (*structValidator[*int, int])(nil)
+	_ parallelizer[*int] = (*structValidator[*int, int])(nil)
+	_ Error              = (*StructError[*int, int])(nil)
 )
 
 // structRule manages its fields.
 type structRule[P ~*T, T any] struct {
-	base   P
-	fields map[string]structFieldRef
+	base     P
+	fields   OrderedMap[string, structFieldRef]
+	rules    []structLevelRule
+	failFast bool
 }
 
+// structLevelRule is the rule that validates whole the struct p.
+type structLevelRule func(ctx context.Context, p any, report ReportFunc)
+
 // Add adds the rule.
 func (r *structRule[P, T]) add(field structFieldRef) {
 	offset := field.offsetFrom(r.base)
 	f := lookupStructField(r.base, offset)
 	field.setIndex(f.Index)
-	r.fields[field.Name()] = field
+	r.fields.set(field.Name(), field)
+}
+
+func (r *structRule[P, T]) addRule(fn structLevelRule) {
+	r.rules = append(r.rules, fn)
+}
+
+func (r *structRule[P, T]) setFailFast() {
+	r.failFast = true
+}
+
+func (r *structRule[P, T]) baseType() reflect.Type {
+	return reflect.TypeOf(r.base)
+}
+
+func (r *structRule[P, T]) indexOf(p any) []int {
+	bp := reflect.ValueOf(r.base).Pointer()
+	pp := reflect.ValueOf(p).Pointer()
+	f := lookupStructField(r.base, pp-bp)
+	return f.Index
 }
 
 func lookupStructField(p any, offset uintptr) reflect.StructField {
@@ -115,10 +275,21 @@ func lookupStructField(p any, offset uintptr) reflect.StructField {
 // StructRule is the interface to add its fields.
 type StructRule interface {
 	add(field structFieldRef)
+	addRule(fn structLevelRule)
+	setFailFast()
+	baseType() reflect.Type
+	indexOf(p any) []int
 }
 
 // AddField adds the p's field of the struct T.
+//
+// AddField panics if vs contains the cross-field validators, such as EqualField, built with the other StructRule.
 func AddField[T any](s StructRule, p *T, name string, vs ...Validator[T]) {
+	for _, v := range vs {
+		if b, ok := v.(structBound); ok && b.structType() != s.baseType() {
+			panic("validator: the validator for " + b.structType().String() + " is added to " + s.baseType().String())
+		}
+	}
 	s.add(&structField[T]{
 		name: name,
 		p:    p,
@@ -126,6 +297,37 @@ func AddField[T any](s StructRule, p *T, name string, vs ...Validator[T]) {
 	})
 }
 
+// FailFast makes the Struct validator stop at the first invalid field in the order of AddField.
+// Then the rest of the fields and the struct-level rules added with AddRule are not validated.
+//
+// If all the fields are valid, the errors reported from struct-level rules are also limited to the first field.
+//
+// Under Parallel, the fields being validated concurrently may be validated even after the first invalid field,
+// but their errors are discarded.
+func FailFast(s StructRule) {
+	s.setFailFast()
+}
+
+// ReportFunc is the function type to report err as a violation of the field that is added as name.
+type ReportFunc func(name string, err error)
+
+// AddRule adds the struct-level rule fn to s.
+//
+// fn is called with the struct after its fields are validated,
+// and it reports the violations with report for one or more fields
+// that are added with AddField. If the field of name is not added, report panics.
+// The reported errors are formatted the same as the errors of the fields.
+//
+// P must be the same type as the struct pointer passed to the build function of Struct.
+func AddRule[P any](s StructRule, fn func(ctx context.Context, p P, report ReportFunc)) {
+	if t := reflect.TypeFor[P](); t != s.baseType() {
+		panic("validator: AddRule is called with " + t.String() + " for " + s.baseType().String())
+	}
+	s.addRule(func(ctx context.Context, p any, report ReportFunc) {
+		fn(ctx, p.(P), report)
+	})
+}
+
 type structField[T any] struct {
 	name  string
 	p     *T
@@ -146,20 +348,18 @@ func (r *structField[T]) setIndex(index []int) {
 }
 
 func (r *structField[T]) validateField(ctx context.Context, base any, key message.Reference, args []Arg) error {
-	p := r.valueOf(base, r.index)
-	v := p.(T)
+	v := r.valueOf(base)
 	var errs []error
 	for _, rule := range r.vs {
-		if err := rule.Validate(ctx, v); err != nil {
-			err = wrapErrors(err, func(err error) error {
-				e := &structFieldError[T]{
-					Name:  r.name,
-					Value: v,
-					Err:   err,
-				}
-				return errors.New(ctxPrint(ctx, e, key, args))
-			})
-			errs = append(errs, err)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := rule.Validate(ctx, v)
+		if err := abortError(ctx, err); err != nil {
+			return err
+		}
+		if err != nil {
+			errs = append(errs, r.wrapError(ctx, v, err, key, args))
 		}
 	}
 	if len(errs) > 0 {
@@ -168,6 +368,23 @@ func (r *structField[T]) validateField(ctx context.Context, base any, key messag
 	return nil
 }
 
+// reportError returns the error that formats each error of err as the error of the field.
+func (r *structField[T]) reportError(ctx context.Context, base any, err error, key message.Reference, args []Arg) error {
+	v := r.valueOf(base)
+	return r.wrapError(ctx, v, err, key, args)
+}
+
+func (r *structField[T]) wrapError(ctx context.Context, v T, err error, key message.Reference, args []Arg) error {
+	return wrapErrors(err, func(err error) error {
+		e := &structFieldError[T]{
+			Name:  r.name,
+			Value: v,
+			Err:   err,
+		}
+		return withPath(PathField(r.name), err, ctxPrint(ctx, e, key, args))
+	})
+}
+
 func wrapErrors(err error, fn func(err error) error) error {
 	errs := flattenErrors(err)
 	for i, err := range errs {
@@ -194,14 +411,21 @@ func (f *structField[T]) offsetFrom(base any) uintptr {
 	return pp - bp
 }
 
-func (f *structField[T]) valueOf(base any, index []int) any {
+// valueOf returns the value of the field in base.
+func (f *structField[T]) valueOf(base any) T {
 	bp := reflect.ValueOf(base)
-	if bp.IsNil() { // TODO(lufia): fix?
+	if bp.IsNil() { // see NilPolicy
 		var t T
 		return t
 	}
-	p := bp.Elem().FieldByIndex(index)
-	return p.Interface()
+	return valueAs[T](bp.Elem().FieldByIndex(f.index))
+}
+
+// valueAs returns the value of p as T.
+// If p holds a nil interface, valueAs returns the zero value of T.
+func valueAs[T any](p reflect.Value) T {
+	v, _ := p.Interface().(T)
+	return v
 }
 
 // structFieldError reports an error is caused in Field validator.
@@ -216,6 +440,7 @@ type structFieldRef interface {
 	Name() string
 	setIndex(index []int)
 	validateField(ctx context.Context, base any, key message.Reference, a []Arg) error
+	reportError(ctx context.Context, base any, err error, key message.Reference, a []Arg) error
 	offsetFrom(base any) uintptr
 }
 
@@ -2,9 +2,10 @@ package validator
 
 import (
 	"context"
-	"testing"
-
+	"errors"
 	"slices"
+	"testing"
+	"time"
 )
 
 func TestStruct(t *testing.T) {
@@ -54,14 +55,213 @@ func testErrors[T any](t *testing.T, err error, want []string) {
 		return
 	}
 	errs := err.(*StructError[*T, T]).Errors
-	e := make([]string, 0, len(errs))
-	for _, err := range errs {
+	e := make([]string, 0, errs.Len())
+	for _, name := range errs.Keys() {
+		err, _ := errs.Get(name)
 		e = append(e, err.Error())
 	}
-	a := slices.Clone(want)
-	slices.Sort(a)
-	slices.Sort(e)
-	if !slices.Equal(e, a) {
+	if !slices.Equal(e, want) {
 		t.Errorf("got %#v; want %#v", e, want)
 	}
 }
+
+func TestStruct_order(t *testing.T) {
+	type Request struct {
+		C string
+		A string
+		B string
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.B, "b", Required[string]())
+		AddField(s, &r.C, "c", Required[string]())
+		AddField(s, &r.A, "a", Required[string]())
+	})
+	for range 10 {
+		err := v.Validate(context.Background(), &Request{})
+		testErrors[Request](t, err, []string{
+			"b: cannot be the zero value",
+			"c: cannot be the zero value",
+			"a: cannot be the zero value",
+		})
+	}
+}
+
+func TestAddRule(t *testing.T) {
+	type Period struct {
+		Start time.Time
+		End   time.Time
+	}
+	v := Struct(func(s StructRule, r *Period) {
+		AddField(s, &r.Start, "start", Required[time.Time]())
+		AddField(s, &r.End, "end")
+		AddRule(s, func(ctx context.Context, r *Period, report ReportFunc) {
+			if !r.End.After(r.Start) {
+				report("end", errors.New("must be after the start"))
+			}
+			if r.End.Sub(r.Start) > 90*24*time.Hour {
+				report("start", errors.New("must be within 90 days"))
+				report("end", errors.New("must be within 90 days"))
+			}
+		})
+	})
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testValidate(t, v, &Period{t0, t0.AddDate(0, 0, 1)}, "")
+
+	err := v.Validate(context.Background(), &Period{t0, t0})
+	testErrors[Period](t, err, []string{
+		"end: must be after the start",
+	})
+
+	err = v.Validate(context.Background(), &Period{t0, t0.AddDate(1, 0, 0)})
+	testErrors[Period](t, err, []string{
+		"start: must be within 90 days",
+		"end: must be within 90 days",
+	})
+
+	err = v.Validate(context.Background(), &Period{End: t0})
+	testErrors[Period](t, err, []string{
+		"start: cannot be the zero value\nstart: must be within 90 days",
+		"end: must be within 90 days",
+	})
+	a := Violations(err)
+	if len(a) != 3 || a[1].Path.String() != "start" || a[1].Message != "must be within 90 days" {
+		t.Errorf("Violations() = %v", a)
+	}
+}
+
+func TestAddRule_nilInterface(t *testing.T) {
+	type Request struct {
+		Err error
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.Err, "err")
+		AddRule(s, func(ctx context.Context, r *Request, report ReportFunc) {
+			if r.Err == nil {
+				report("err", errors.New("is missing"))
+			}
+		})
+	})
+	testValidate(t, v, &Request{}, "err: is missing")
+}
+
+func TestAddRule_unknownField(t *testing.T) {
+	type Request struct {
+		Name string
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		AddRule(s, func(ctx context.Context, r *Request, report ReportFunc) {
+			report("name", errors.New("invalid"))
+		})
+	})
+	defer func() {
+		if e := recover(); e == nil {
+			t.Errorf("Validate should panic")
+		}
+	}()
+	v.Validate(context.Background(), &Request{})
+}
+
+func TestAddRule_typeMismatch(t *testing.T) {
+	type Request struct {
+		Name string
+	}
+	defer func() {
+		if e := recover(); e == nil {
+			t.Errorf("AddRule should panic")
+		}
+	}()
+	Struct(func(s StructRule, r *Request) {
+		AddRule(s, func(ctx context.Context, r *struct{ Name string }, report ReportFunc) {})
+	})
+}
+
+func TestWithNilPolicy(t *testing.T) {
+	type (
+		User struct {
+			ID string
+		}
+		Request struct {
+			User *User
+		}
+	)
+	userValidator := Struct(func(s StructRule, u *User) {
+		AddField(s, &u.ID, "id", Required[string]())
+	})
+	tests := map[string]struct {
+		policy NilPolicy
+		want   string
+	}{
+		"zero":    {NilAsZero, "user: id: cannot be the zero value"},
+		"valid":   {NilAsValid, ""},
+		"invalid": {NilAsInvalid, "user: must not be nil"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			v := Struct(func(s StructRule, r *Request) {
+				AddField(s, &r.User, "user", WithNilPolicy(userValidator, tt.policy))
+			})
+			testValidate(t, v, &Request{}, tt.want)
+			testValidate(t, v, &Request{User: &User{ID: "x"}}, "")
+			testValidate(t, v, &Request{User: &User{}}, "user: id: cannot be the zero value")
+		})
+	}
+}
+
+func TestWithNilPolicyWithFormat(t *testing.T) {
+	type User struct {
+		ID string
+	}
+	v := WithNilPolicy(Struct(func(s StructRule, u *User) {}), NilAsInvalid).WithFormat("is missing")
+	testValidate(t, v, nil, "is missing")
+}
+
+func TestFailFast(t *testing.T) {
+	type Request struct {
+		Name  string
+		Email string
+	}
+	var called bool
+	v := Struct(func(s StructRule, r *Request) {
+		FailFast(s)
+		AddField(s, &r.Name, "name", Required[string]())
+		AddField(s, &r.Email, "email", New(func(ctx context.Context, s string) bool {
+			called = true
+			return s != ""
+		}))
+		AddRule(s, func(ctx context.Context, r *Request, report ReportFunc) {
+			called = true
+		})
+	})
+	err := v.Validate(context.Background(), &Request{})
+	testErrors[Request](t, err, []string{
+		"name: cannot be the zero value",
+	})
+	if called {
+		t.Errorf("the rest of rules should not be called")
+	}
+
+	err = v.Validate(context.Background(), &Request{Name: "x"})
+	testErrors[Request](t, err, []string{
+		"email: must be a valid value",
+	})
+}
+
+func TestFailFast_rule(t *testing.T) {
+	type Request struct {
+		A string
+		B string
+	}
+	v := Struct(func(s StructRule, r *Request) {
+		FailFast(s)
+		AddField(s, &r.A, "a")
+		AddField(s, &r.B, "b")
+		AddRule(s, func(ctx context.Context, r *Request, report ReportFunc) {
+			report("b", errors.New("invalid"))
+			report("a", errors.New("invalid"))
+		})
+	})
+	err := v.Validate(context.Background(), &Request{})
+	testErrors[Request](t, err, []string{
+		"a: invalid",
+	})
+}
@@ -6,22 +6,51 @@ Package validator enables strongly-typed Validate methods to validate any types.
 There are builtin validators.
   - In
   - InRange
+  - ItemsRange
   - Length
   - Max
+  - MaxItems
   - MaxLength
   - Min
+  - MinItems
   - MinLength
+  - NonNil
   - Pattern
   - Required
+  - RequiredInterface
+  - RequiredMap
+  - RequiredSlice
+  - Unique
+  - UniqueFunc
 
 When these builtin validators detects the value is invalid,
 they returns just an error corresponding to the validator.
 In other words, they don't return multiple errors wrapped by errors.Join.
 
+The error is a *RuleError that holds the rule name and its parameters.
+It can be extracted with errors.As even if it is wrapped by composition validators.
+
+	var e *validator.RuleError
+	if errors.As(err, &e) {
+		fmt.Println(e.Rule, e.Params["min"])
+	}
+
 Also there are few composition validators.
+  - Array
+  - Chain
   - Join
+  - Map
+  - Not
+  - Optional
+  - Or
+  - Pointer
+  - PointerOf
+  - RequiredPointer
   - Slice
+  - SliceOf
   - Struct
+  - Unless
+  - When
 
 These validators wraps multiple validators (including composition validators itself),
 so it could be contained multiple errors to a returned error from them.
@@ -41,6 +70,13 @@ To get the details:
 		fmt.Println(e.Errors)
 	}
 
+	// Map validator
+	v := validator.Map(validator.MinLength[string](1), validator.Min(0))
+	err := v.Validate(context.Background(), map[string]int{"a": -1})
+	if e, ok := err.(*validator.MapError[map[string]int, string, int]); ok {
+		fmt.Println(e.Errors)
+	}
+
 	// Struct validator
 	v := validator.Struct(func(s validator.StructRule, r *Data) {
 		// ...
@@ -50,10 +86,65 @@ To get the details:
 		fmt.Println(e.Errors)
 	}
 
+# Cross-field validators
+
+There are validators to compare the value of a field with another field in the same struct.
+  - EqualField
+  - GreaterThanField
+  - LessThanField
+  - NotEqualField
+
+These validators are created in the build function of Struct,
+and their errors are reported under the name of the field passed to AddField.
+
+	v := validator.Struct(func(s validator.StructRule, r *Data) {
+		validator.AddField(s, &r.End, "end", validator.GreaterThanField(s, &r.Start, "start"))
+	})
+
+# Struct-level rules
+
+AddRule adds the rule that validates whole the struct in the build function of Struct.
+The rule reports its violations as the errors of the fields added with AddField.
+
+	v := validator.Struct(func(s validator.StructRule, r *Period) {
+		validator.AddField(s, &r.End, "end")
+		validator.AddRule(s, func(ctx context.Context, r *Period, report validator.ReportFunc) {
+			if r.End.Sub(r.Start) > 90*24*time.Hour {
+				report("end", errors.New("must be within 90 days"))
+			}
+		})
+	})
+
+# Error locations
+
+The errors caused in Array, Map, Slice and Struct validators are wrapped with *PathError.
+It holds the location of the invalid value as Path.
+Violations flattens the error returned from Validate into a list of Violation
+that contains its Path, the localized message and the rule name.
+
+	for _, v := range validator.Violations(err) {
+		fmt.Printf("%s: %s (%s)\n", v.Path, v.Message, v.Rule)
+	}
+
 # Custom validator
 
 The New utility function makes it easy to implement custom validators.
 
+NewWithError is like New but its function returns an error.
+The function returns ErrInvalid or the error created with Invalid when the value is invalid.
+The other errors are reported as *InternalError that is distinguished from validation errors.
+
+	v := validator.NewWithError(func(ctx context.Context, name string) error {
+		ok, err := users.Exists(ctx, name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return validator.Invalid("%[1]q is already taken", name)
+		}
+		return nil
+	})
+
 We highly recommend to set custom error message with WithFormat to that validator.
 It also has default error message but it might be a unsufficient to your users.
 
@@ -69,6 +160,28 @@ For example:
 It is different for each the validator to be available argument names with ByName.
 See each the validator documentation.
 
+# Cancellation
+
+The composition validators stop the validation when ctx passed to Validate is done.
+Then they return ctx.Err() as is, so that it can be distinguished from validation errors.
+Similarly, they stop the validation and return *InternalError as is when it is returned from their validators.
+
+	err := v.Validate(ctx, &r)
+	if errors.Is(err, context.Canceled) {
+		// ...
+	}
+	var e *validator.InternalError
+	if errors.As(err, &e) {
+		// ...
+	}
+
+# Parallel validation
+
+Parallel makes Array, Slice and Struct validators validate their elements or fields concurrently.
+The number of goroutines is limited to n, and the errors are reported in the same order as the sequential validation.
+
+	v := validator.Parallel(validator.Slice(validator.New(lookup)), 8)
+
 # Internationalization
 
 The validators error messages are available in multiple languages.
@@ -77,10 +190,17 @@ The validator package assumes English is default language.
 To switch default language to another one,
 it is set Printer provided by [golang.org/x/text/message] to ctx that
 will be passed to the first argument of Validate[T] method.
+
+WithLanguage and WithAcceptLanguage set the Printer for the language
+that is best matched among the languages available in DefaultCatalog.
+The matched language is available with LanguageFrom.
+
+	ctx := validator.WithAcceptLanguage(context.Background(), "ja-JP,en;q=0.8")
 */
 package validator
 
 import (
+	"bytes"
 	"context"
 	"errors"
 
@@ -98,11 +218,52 @@ type Error interface {
 	error
 }
 
+// RuleError reports an error is caused in the builtin validators.
+//
+// Its message is formatted with the Printer in the context passed to Validate,
+// and its details are available as structured values.
+type RuleError struct {
+	// Rule is the name of the rule that v does not satisfy, such as "required" or "min".
+	Rule string
+
+	// Value is the user input.
+	Value any
+
+	// Params is the parameters of the rule.
+	// Its keys are the same as the named args available in its error format, except for "value".
+	Params map[string]any
+
+	msg string
+}
+
+func newRuleError(ctx context.Context, rule string, e any, format *errorFormat) *RuleError {
+	params := argsOf(e)
+	v := params["value"]
+	delete(params, "value")
+	return &RuleError{
+		Rule:   rule,
+		Value:  v,
+		Params: params,
+		msg:    ctxPrint(ctx, e, format.Key, format.Args),
+	}
+}
+
+// Error implements the error interface.
+func (e *RuleError) Error() string {
+	return e.msg
+}
+
 // Join bundles vs to a validator.
+//
+// By default, the errors of vs are reported as is.
+// Once WithFormat is called, each error is formatted with its format.
+// Two named args are available in its error format.
+//   - value: user input (type T)
+//   - error: occurred validation error (type error)
 func Join[T any](vs ...Validator[T]) Validator[T] {
 	var a []Validator[T]
 	for _, v := range vs {
-		if p, ok := v.(*joinValidator[T]); ok {
+		if p, ok := v.(*joinValidator[T]); ok && p.format == nil && !p.failFast {
 			a = append(a, p.vs...)
 		} else {
 			a = append(a, v)
@@ -111,15 +272,32 @@ func Join[T any](vs ...Validator[T]) Validator[T] {
 	return &joinValidator[T]{vs: a}
 }
 
+// Chain is like Join but the returned validator stops at the first validator that returns an error.
+// Therefore the rest of vs are not called after the value is found to be invalid.
+//
+// The named args available in its error format are the same as Join.
+func Chain[T any](vs ...Validator[T]) Validator[T] {
+	var a []Validator[T]
+	for _, v := range vs {
+		if p, ok := v.(*joinValidator[T]); ok && p.format == nil && p.failFast {
+			a = append(a, p.vs...)
+		} else {
+			a = append(a, v)
+		}
+	}
+	return &joinValidator[T]{vs: a, failFast: true}
+}
+
 type joinValidator[T any] struct {
-	vs []Validator[T]
+	vs       []Validator[T]
+	failFast bool
+	format   *errorFormat
 }
 
 // WithFormat returns shallow copy of r with its error format changed to key.
-//
-// TODO(lufia): currently key is always ignored.
 func (r *joinValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T] {
 	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
 	return &rr
 }
 
@@ -127,14 +305,74 @@ func (r *joinValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator
 func (r *joinValidator[T]) Validate(ctx context.Context, v T) error {
 	var errs []error
 	for _, p := range r.vs {
-		if err := p.Validate(ctx, v); err != nil {
-			errs = append(errs, err)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := p.Validate(ctx, v)
+		if err := abortError(ctx, err); err != nil {
+			return err
+		}
+		if err != nil {
+			errs = append(errs, formatErrors(ctx, err, r.format, func(err error) any {
+				return &joinedError[T]{
+					Value: v,
+					Err:   err,
+				}
+			}))
+			if r.failFast {
+				break
+			}
 		}
 	}
 	return joinErrors(errs...)
 }
 
-var _ Validator[string] = (*joinValidator[string])(nil)
+// ruleError returns the error that reports v does not satisfy r.
+// If r has only one validator, it is the error of the validator.
+// Otherwise its rule name is "join".
+func (r *joinValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	if len(r.vs) == 1 {
+		return ruleErrorOf(ctx, r.vs[0], v)
+	}
+	errs := make(errorList, len(r.vs))
+	for i, p := range r.vs {
+		errs[i] = ruleErrorOf(ctx, p, v)
+	}
+	e := &joinRuleError[T]{
+		Errors: errs,
+		Value:  v,
+	}
+	return newRuleError(ctx, "join", e, joinErrorFormat)
+}
+
+// joinRuleError reports v does not satisfy Join validator.
+type joinRuleError[T any] struct {
+	Errors errorList `arg:"errors"`
+	Value  T         `arg:"value"`
+}
+
+// joinedError reports an error is caused in Join validator.
+type joinedError[T any] struct {
+	Value T     `arg:"value"`
+	Err   error `arg:"error"`
+}
+
+// formatErrors returns the error that each error of err is formatted with format.
+// The arguments of the format are retrieved from the value returned from fn.
+// If format is nil, formatErrors returns err as is.
+func formatErrors(ctx context.Context, err error, format *errorFormat, fn func(err error) any) error {
+	if format == nil {
+		return err
+	}
+	return wrapErrors(err, func(err error) error {
+		return withMessage(err, ctxPrint(ctx, fn(err), format.Key, format.Args))
+	})
+}
+
+var (
+	_ Validator[string] = (*joinValidator[string])(nil)
+	_ ruler[string]     = (*joinValidator[string])(nil)
+)
 
 // OrderedMap is a map that guarantee that the iteration order of entries
 // will be the order in which they were set.
@@ -172,6 +410,7 @@ func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
 
 // New returns the validator to verify the value with fn.
 // If fn returns false, the validator results as error.
+// The rule name of its error is "custom".
 //
 // A named args is available in its error format.
 //   - value: user input (type T)
@@ -197,16 +436,156 @@ func (r *customValidator[T]) WithFormat(key message.Reference, a ...Arg) Validat
 // Validate returns the all errors that v is validated with its each validator.
 func (r *customValidator[T]) Validate(ctx context.Context, v T) error {
 	if !r.fn(ctx, v) {
-		e := &customError[T]{
-			Value: v,
-		}
-		return errors.New(ctxPrint(ctx, e, r.format.Key, r.format.Args))
+		return r.ruleError(ctx, v)
 	}
 	return nil
 }
 
+// ruleError returns the error that reports v does not satisfy r.
+func (r *customValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &customError[T]{
+		Value: v,
+	}
+	return newRuleError(ctx, "custom", e, r.format)
+}
+
 type customError[T any] struct {
 	Value T `arg:"value"`
 }
 
-var _ Validator[string] = (*customValidator[string])(nil)
+// ErrInvalid is the error that reports the value is invalid.
+// The function passed to NewWithError returns it, or the error wrapping it, when the value is invalid.
+var ErrInvalid = errors.New("invalid value")
+
+// Invalid returns the error that reports the value is invalid with the message key and its args a.
+// The returned error wraps ErrInvalid.
+//
+// When the function passed to NewWithError returns the error,
+// the validator uses its message localized with the Printer in ctx instead of its error format.
+//
+//	return validator.Invalid("%[1]q is already taken", name)
+func Invalid(key message.Reference, a ...any) error {
+	return &invalidError{
+		key:  key,
+		args: a,
+	}
+}
+
+// invalidError is the error returned from Invalid.
+type invalidError struct {
+	key  message.Reference
+	args []any
+}
+
+// Error implements the error interface.
+func (e *invalidError) Error() string {
+	var w bytes.Buffer
+	defaultPrinter.Fprintf(&w, e.key, e.args...)
+	return w.String()
+}
+
+// Unwrap returns ErrInvalid.
+func (e *invalidError) Unwrap() error {
+	return ErrInvalid
+}
+
+// InternalError reports the validator cannot determine whether the value is valid or not.
+// For example, the function passed to NewWithError fails to look up the value in a database.
+//
+// It is not a validation error.
+// The composition validators stop the validation and return the error as is.
+type InternalError struct {
+	Err error
+}
+
+// Error implements the error interface.
+func (e *InternalError) Error() string {
+	return "validator: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error of e.
+func (e *InternalError) Unwrap() error {
+	return e.Err
+}
+
+// abortError returns the error that makes the composition validators stop the validation.
+// It is ctx.Err() if ctx is done, or *InternalError contained in err.
+// Otherwise abortError returns nil.
+func abortError(ctx context.Context, err error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var e *InternalError
+	if errors.As(err, &e) {
+		return e
+	}
+	return nil
+}
+
+// NewWithError is like New but fn reports the result as an error.
+// The rule name of its error is "custom".
+//
+// If fn returns nil, the value is valid.
+// If fn returns the error created with Invalid, or the error wrapping ErrInvalid, the value is invalid.
+// Otherwise the validator returns *InternalError that wraps the error returned from fn.
+// If ctx is done, the validator returns ctx.Err() as is.
+//
+// The named args available in its error format are the same as New.
+// The format is not used for the errors created with Invalid.
+func NewWithError[T any](fn func(ctx context.Context, v T) error) Validator[T] {
+	return &customErrorValidator[T]{
+		fn:     fn,
+		format: customErrorFormat,
+	}
+}
+
+type customErrorValidator[T any] struct {
+	fn     func(ctx context.Context, v T) error
+	format *errorFormat
+}
+
+// WithFormat returns shallow copy of r with its error format changed to key.
+func (r *customErrorValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T] {
+	rr := *r
+	rr.format = &errorFormat{Key: key, Args: a}
+	return &rr
+}
+
+// Validate validates v with its function.
+func (r *customErrorValidator[T]) Validate(ctx context.Context, v T) error {
+	err := r.fn(ctx, v)
+	if err == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var e *invalidError
+	switch {
+	case errors.As(err, &e):
+		re := r.ruleError(ctx, v)
+		var w bytes.Buffer
+		PrinterFrom(ctx).Fprintf(&w, e.key, e.args...)
+		re.msg = w.String()
+		return re
+	case errors.Is(err, ErrInvalid):
+		return r.ruleError(ctx, v)
+	default:
+		return &InternalError{Err: err}
+	}
+}
+
+// ruleError returns the error that reports v does not satisfy r.
+func (r *customErrorValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	e := &customError[T]{
+		Value: v,
+	}
+	return newRuleError(ctx, "custom", e, r.format)
+}
+
+var (
+	_ Validator[string] = (*customValidator[string])(nil)
+	_ Validator[string] = (*customErrorValidator[string])(nil)
+	_ Error             = (*RuleError)(nil)
+	_ Error             = (*InternalError)(nil)
+)
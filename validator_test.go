@@ -2,8 +2,14 @@ package validator
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
 )
 
 func testValidate[V Validator[T], T any](t *testing.T, v V, p T, e string) {
@@ -105,3 +111,350 @@ func TestNewWithFormat(t *testing.T) {
 		testValidate(t, v, "", "is empty")
 	})
 }
+
+func TestRuleError(t *testing.T) {
+	tests := map[string]struct {
+		err    error
+		rule   string
+		params map[string]any
+	}{
+		"required": {Required[string]().Validate(context.Background(), ""), "required", map[string]any{}},
+		"min":      {Min(3).Validate(context.Background(), 2), "min", map[string]any{"min": 3}},
+		"length":   {Length[string](1, 3).Validate(context.Background(), ""), "length", map[string]any{"min": 1, "max": 3}},
+		"in":       {In("a", "b").Validate(context.Background(), "c"), "in", map[string]any{"validValues": []string{"a", "b"}}},
+		"struct": {
+			Struct(func(s StructRule, r *struct{ N int }) {
+				AddField(s, &r.N, "n", Max(1))
+			}).Validate(context.Background(), &struct{ N int }{N: 2}),
+			"max",
+			map[string]any{"max": 1},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var e *RuleError
+			if !errors.As(tt.err, &e) {
+				t.Fatalf("errors.As(%v) = false; want true", tt.err)
+			}
+			if e.Rule != tt.rule {
+				t.Errorf("Rule = %q; want %q", e.Rule, tt.rule)
+			}
+			if !reflect.DeepEqual(e.Params, tt.params) {
+				t.Errorf("Params = %v; want %v", e.Params, tt.params)
+			}
+		})
+	}
+}
+
+func TestJoinWithFormat(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		v := Join(Min(3), Max(1)).WithFormat("%v: %v", ByName("value"), ByName("error"))
+		testValidate(t, v, 2, "2: must be no less than 3\n2: must be no greater than 1")
+	})
+	t.Run("nested", func(t *testing.T) {
+		v := Join(Join(Min(3)).WithFormat("[%v]", ByName("error")), Max(1))
+		if n := len(v.(*joinValidator[int]).vs); n != 2 {
+			t.Errorf("got %d; want 2", n)
+		}
+		testValidate(t, v, 2, "[must be no less than 3]\nmust be no greater than 1")
+	})
+}
+
+func TestChain(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		v := Chain(Required[string](), MinLength[string](3))
+		testValidate(t, v, "abc", "")
+		testValidate(t, v, "", "cannot be the zero value")
+		testValidate(t, v, "ab", "the length must be no less than 3")
+	})
+	t.Run("shortCircuit", func(t *testing.T) {
+		var called bool
+		v := Chain(Min(3), New(func(ctx context.Context, n int) bool {
+			called = true
+			return true
+		}))
+		testValidate(t, v, 1, "must be no less than 3")
+		if called {
+			t.Errorf("the second validator should not be called")
+		}
+	})
+	t.Run("nested", func(t *testing.T) {
+		v := Chain(Chain(Min(1), Max(10)), Join(Min(3), Max(2)))
+		if n := len(v.(*joinValidator[int]).vs); n != 3 {
+			t.Errorf("got %d; want 3", n)
+		}
+		testValidate(t, v, 0, "must be no less than 1")
+		testValidate(t, v, 2, "must be no less than 3")
+		testValidate(t, v, 5, "must be no greater than 2")
+	})
+	t.Run("join", func(t *testing.T) {
+		v := Join(Chain(Min(3), Max(1)), Max(0))
+		if n := len(v.(*joinValidator[int]).vs); n != 2 {
+			t.Errorf("got %d; want 2", n)
+		}
+		testValidate(t, v, 2, "must be no less than 3\nmust be no greater than 0")
+	})
+}
+
+func TestValidate_canceled(t *testing.T) {
+	type Request struct {
+		Name  string
+		Items []int
+	}
+	tests := map[string]func(ctx context.Context) error{
+		"join": func(ctx context.Context) error {
+			return Join(Min(1), Max(0)).Validate(ctx, 1)
+		},
+		"slice": func(ctx context.Context) error {
+			return Slice(Min(1)).Validate(ctx, []int{0, 0})
+		},
+		"array": func(ctx context.Context) error {
+			return Array[[2]int](Min(1)).Validate(ctx, [2]int{})
+		},
+		"map": func(ctx context.Context) error {
+			return Map(MinLength[string](2), Min(1)).Validate(ctx, map[string]int{"a": 0})
+		},
+		"pointer": func(ctx context.Context) error {
+			n := 0
+			return Pointer(Min(1)).Validate(ctx, &n)
+		},
+		"or": func(ctx context.Context) error {
+			return Or(Min(1), Max(-1)).Validate(ctx, 0)
+		},
+		"not": func(ctx context.Context) error {
+			return Not(Min(1)).Validate(ctx, 0)
+		},
+		"struct": func(ctx context.Context) error {
+			v := Struct(func(s StructRule, r *Request) {
+				AddField(s, &r.Name, "name", Required[string]())
+				AddField(s, &r.Items, "items", Slice(Min(1)))
+			})
+			return v.Validate(ctx, &Request{Items: []int{0}})
+		},
+	}
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			if err := fn(ctx); !errors.Is(err, context.Canceled) {
+				t.Errorf("Validate() = %v; want %v", err, context.Canceled)
+			}
+		})
+	}
+}
+
+func TestValidate_canceledBeforeValidate(t *testing.T) {
+	type Request struct {
+		Name string
+	}
+	var n int
+	expensive := New(func(ctx context.Context, v int) bool {
+		n++
+		return true
+	})
+	tests := map[string]func(ctx context.Context) error{
+		"join": func(ctx context.Context) error {
+			return Join(expensive).Validate(ctx, 1)
+		},
+		"chain": func(ctx context.Context) error {
+			return Chain(expensive).Validate(ctx, 1)
+		},
+		"pointer": func(ctx context.Context) error {
+			v := 1
+			return Pointer(expensive).Validate(ctx, &v)
+		},
+		"or": func(ctx context.Context) error {
+			return Or(expensive).Validate(ctx, 1)
+		},
+		"not": func(ctx context.Context) error {
+			return Not(expensive).Validate(ctx, 1)
+		},
+		"slice": func(ctx context.Context) error {
+			return Slice(expensive).Validate(ctx, []int{1})
+		},
+		"map": func(ctx context.Context) error {
+			return Map[string](nil, expensive).Validate(ctx, map[string]int{"a": 1})
+		},
+		"struct": func(ctx context.Context) error {
+			v := Struct(func(s StructRule, r *Request) {
+				AddField(s, &r.Name, "name", MinLength[string](1))
+				AddRule(s, func(ctx context.Context, r *Request, report ReportFunc) {
+					n++
+				})
+			})
+			return v.Validate(ctx, &Request{})
+		},
+	}
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			n = 0
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			if err := fn(ctx); err != context.Canceled {
+				t.Errorf("Validate() = %v; want %v", err, context.Canceled)
+			}
+			if n != 0 {
+				t.Errorf("the validator is called %d times; want 0", n)
+			}
+		})
+	}
+}
+
+func TestSlice_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var n int
+	v := Slice(New(func(ctx context.Context, i int) bool {
+		n++
+		if i == 2 {
+			cancel()
+		}
+		return false
+	}))
+	err := v.Validate(ctx, []int{0, 1, 2, 3, 4})
+	if err != context.Canceled {
+		t.Errorf("Validate() = %v; want %v", err, context.Canceled)
+	}
+	if n != 3 {
+		t.Errorf("validated %d elements; want 3", n)
+	}
+}
+
+func TestNewWithError(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+	v := NewWithError(func(ctx context.Context, s string) error {
+		switch s {
+		case "taken":
+			return Invalid("%[1]q is already taken", s)
+		case "reserved":
+			return fmt.Errorf("%s: %w", s, ErrInvalid)
+		case "error":
+			return errLookup
+		}
+		return nil
+	})
+	testValidate(t, v, "ok", "")
+	testValidate(t, v, "taken", `"taken" is already taken`)
+	testValidate(t, v, "reserved", "must be a valid value")
+
+	err := v.Validate(context.Background(), "taken")
+	var re *RuleError
+	if !errors.As(err, &re) || re.Rule != "custom" || re.Value != "taken" {
+		t.Errorf("Validate(%q) = %#v; want a RuleError", "taken", err)
+	}
+
+	err = v.Validate(context.Background(), "error")
+	var e *InternalError
+	if !errors.As(err, &e) || !errors.Is(err, errLookup) {
+		t.Errorf("Validate(%q) = %v; want *InternalError", "error", err)
+	}
+	if errors.Is(err, ErrInvalid) {
+		t.Errorf("Validate(%q) = %v; should not be ErrInvalid", "error", err)
+	}
+	if a := Violations(err); a != nil {
+		t.Errorf("Violations(%v) = %v; want nil", err, a)
+	}
+}
+
+func TestNewWithErrorWithFormat(t *testing.T) {
+	v := NewWithError(func(ctx context.Context, s string) error {
+		if s == "taken" {
+			return Invalid("%[1]q is already taken", s)
+		}
+		if s == "" {
+			return ErrInvalid
+		}
+		return nil
+	}).WithFormat("%[1]q is not allowed", ByName("value"))
+	testValidate(t, v, "", `"" is not allowed`)
+	testValidate(t, v, "taken", `"taken" is already taken`)
+}
+
+func TestNewWithError_localized(t *testing.T) {
+	key := "%[1]q is already taken"
+	c := catalog.NewBuilder()
+	c.SetString(language.Japanese, key, "%[1]qは既に使われています")
+	v := NewWithError(func(ctx context.Context, s string) error {
+		return Invalid(key, s)
+	})
+	p := message.NewPrinter(language.Japanese, message.Catalog(c))
+	ctx := WithPrinter(context.Background(), p)
+	err := v.Validate(ctx, "alice")
+	if want := `"alice"は既に使われています`; err == nil || err.Error() != want {
+		t.Errorf("Validate() = %v; want %s", err, want)
+	}
+}
+
+func TestNewWithError_internalError(t *testing.T) {
+	type Request struct {
+		Name  string
+		Items []string
+	}
+	errLookup := errors.New("lookup failed")
+	lookup := NewWithError(func(ctx context.Context, s string) error {
+		if s == "error" {
+			return errLookup
+		}
+		return ErrInvalid
+	})
+	tests := map[string]Validator[*Request]{
+		"struct": Struct(func(s StructRule, r *Request) {
+			AddField(s, &r.Name, "name", Required[string](), lookup)
+		}),
+		"slice": Struct(func(s StructRule, r *Request) {
+			AddField(s, &r.Items, "items", Slice(lookup))
+		}),
+		"parallel": Struct(func(s StructRule, r *Request) {
+			AddField(s, &r.Items, "items", Parallel(Slice(lookup), 2))
+		}),
+		"or": Struct(func(s StructRule, r *Request) {
+			AddField(s, &r.Name, "name", Or(lookup, Not(lookup)), Chain(lookup))
+		}),
+	}
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := v.Validate(context.Background(), &Request{
+				Name:  "error",
+				Items: []string{"a", "error", "b"},
+			})
+			var e *InternalError
+			if !errors.As(err, &e) || e.Err != errLookup {
+				t.Errorf("Validate() = %v; want *InternalError", err)
+			}
+			if _, ok := err.(*InternalError); !ok {
+				t.Errorf("Validate() = %T; want the error returned as is", err)
+			}
+		})
+	}
+}
+
+func TestAddRule_internalError(t *testing.T) {
+	type Request struct {
+		A string
+		B string
+	}
+	errLookup := &InternalError{Err: errors.New("lookup failed")}
+	v := Struct(func(s StructRule, r *Request) {
+		AddField(s, &r.A, "a", Required[string]())
+		AddField(s, &r.B, "b")
+		AddRule(s, func(ctx context.Context, r *Request, report ReportFunc) {
+			report("b", errors.New("invalid"))
+			report("a", errLookup)
+		})
+	})
+	err := v.Validate(context.Background(), &Request{})
+	if err != errLookup {
+		t.Errorf("Validate() = %v; want %v as is", err, errLookup)
+	}
+}
+
+func TestViolations_internalError(t *testing.T) {
+	err := joinErrors(
+		withPath(PathField("name"), Required[string]().Validate(context.Background(), ""), "name: cannot be the zero value"),
+		&InternalError{Err: errors.New("lookup failed")},
+	)
+	a := Violations(err)
+	if len(a) != 1 || a[0].Path.String() != "name" || a[0].Rule != "required" {
+		t.Errorf("Violations() = %v; want only the violation of name", a)
+	}
+}
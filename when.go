@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"context"
+
+	"golang.org/x/text/message"
+)
+
+// When returns the validator to verify the value with vs only if cond returns true.
+// Otherwise the validator always passes.
+//
+// The validator reports the errors the same as Join(vs...).
+func When[T any](cond func(ctx context.Context, v T) bool, vs ...Validator[T]) Validator[T] {
+	return &whenValidator[T]{
+		cond: cond,
+		v:    Join(vs...),
+	}
+}
+
+// Unless returns the validator to verify the value with vs only if cond returns false.
+// Otherwise the validator always passes.
+//
+// The validator reports the errors the same as Join(vs...).
+func Unless[T any](cond func(ctx context.Context, v T) bool, vs ...Validator[T]) Validator[T] {
+	return &whenValidator[T]{
+		cond:   cond,
+		negate: true,
+		v:      Join(vs...),
+	}
+}
+
+// whenValidator represents the validator to check the value on the condition.
+type whenValidator[T any] struct {
+	cond   func(ctx context.Context, v T) bool
+	negate bool
+	v      Validator[T]
+}
+
+// WithFormat returns shallow copy of r with the error format of its validators changed to key.
+func (r *whenValidator[T]) WithFormat(key message.Reference, a ...Arg) Validator[T] {
+	rr := *r
+	rr.v = r.v.WithFormat(key, a...)
+	return &rr
+}
+
+// Validate validates v.
+func (r *whenValidator[T]) Validate(ctx context.Context, v T) error {
+	if r.cond(ctx, v) == r.negate {
+		return nil
+	}
+	return r.v.Validate(ctx, v)
+}
+
+// ruleError returns the error that reports v does not satisfy the validators of r.
+func (r *whenValidator[T]) ruleError(ctx context.Context, v T) *RuleError {
+	return ruleErrorOf(ctx, r.v, v)
+}
+
+var (
+	_ Validator[string] = (*whenValidator[string])(nil)
+	_ ruler[string]     = (*whenValidator[string])(nil)
+)
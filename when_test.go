@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWhen(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		nonEmpty := func(ctx context.Context, s string) bool { return s != "" }
+		v := When(nonEmpty, MinLength[string](3))
+		testValidate(t, v, "", "")
+		testValidate(t, v, "abc", "")
+		testValidate(t, v, "ab", "the length must be no less than 3")
+	})
+	t.Run("struct", func(t *testing.T) {
+		type Address struct {
+			Country string
+			State   string
+		}
+		isUS := func(ctx context.Context, a *Address) bool { return a.Country == "US" }
+		v := Join(
+			Struct(func(s StructRule, a *Address) {
+				AddField(s, &a.Country, "country", Required[string]())
+			}),
+			When(isUS, Struct(func(s StructRule, a *Address) {
+				AddField(s, &a.State, "state", Required[string]())
+			})),
+		)
+		testValidate(t, v, &Address{Country: "JP"}, "")
+		testValidate(t, v, &Address{Country: "US", State: "CA"}, "")
+		testValidate(t, v, &Address{Country: "US"}, "state: cannot be the zero value")
+	})
+}
+
+func TestUnless(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		empty := func(ctx context.Context, s string) bool { return s == "" }
+		v := Unless(empty, MinLength[string](3), PatternString[string]("^[a-z]+$"))
+		testValidate(t, v, "", "")
+		testValidate(t, v, "abc", "")
+		testValidate(t, v, "A", "the length must be no less than 3\nmust match the pattern /^[a-z]+$/")
+	})
+}